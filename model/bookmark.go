@@ -0,0 +1,26 @@
+package model
+
+// Merge combines a duplicate of the bookmark, one that resolved to the same canonical URL, into the bookmark: the
+// duplicate's taxonomies, categorizations and properties are unioned and its title, summary and body fill in those that are empty
+func (b *Bookmark) Merge(duplicate *Bookmark) {
+	if len(b.Title) == 0 {
+		b.Title = duplicate.Title
+	}
+	if len(b.Summary) == 0 {
+		b.Summary = duplicate.Summary
+	}
+	if len(b.Body) == 0 {
+		b.Body = duplicate.Body
+	}
+	b.Taxonomies = MergeTaxonomies(b.Taxonomies, duplicate.Taxonomies)
+	for _, categorization := range duplicate.Categorizations {
+		b.addCategorization(categorization)
+	}
+	if b.Properties == nil {
+		b.Properties = MakeProperties()
+	}
+	b.Properties.Merge(duplicate.Properties)
+	if b.Scores == nil {
+		b.Scores = duplicate.Scores
+	}
+}
@@ -0,0 +1,144 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Matches returns true if the bookmark satisfies every condition of the rule; a rule without conditions never
+// matches so that a misconfigured rule can't categorize every bookmark
+func (rule ContentCategorizationRule) Matches(bookmark *Bookmark) bool {
+	conditions := 0
+	if len(rule.Domains) > 0 {
+		conditions++
+		if bookmark.Link.FinalURL == nil || !rule.matchesDomain(bookmark.Link.FinalURL.URL().Hostname()) {
+			return false
+		}
+	}
+	if rule.URLRegExpr != nil {
+		conditions++
+		if bookmark.Link.FinalURL == nil || !rule.URLRegExpr.MatchString(bookmark.Link.FinalURL.Text()) {
+			return false
+		}
+	}
+	if len(rule.TitleKeywords) > 0 {
+		conditions++
+		if !containsAnyKeyword(string(bookmark.Title), rule.TitleKeywords) {
+			return false
+		}
+	}
+	if len(rule.BodyKeywords) > 0 {
+		conditions++
+		if !containsAnyKeyword(string(bookmark.Body), rule.BodyKeywords) {
+			return false
+		}
+	}
+	if rule.PropertyName != nil {
+		conditions++
+		if bookmark.Properties == nil {
+			return false
+		}
+		value, ok := bookmark.Properties.Get(*rule.PropertyName)
+		if !ok || (rule.PropertyValueRegExpr != nil && !rule.PropertyValueRegExpr.MatchString(fmt.Sprint(value))) {
+			return false
+		}
+	}
+	return conditions > 0
+}
+
+func (rule ContentCategorizationRule) matchesDomain(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	for _, domain := range rule.Domains {
+		if _, ok := matchDomain(strings.ToLower(domain), host); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAnyKeyword returns true if text contains any of the keywords as whole words, ignoring case
+func containsAnyKeyword(text string, keywords []string) bool {
+	text = strings.ToLower(text)
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if len(keyword) == 0 {
+			continue
+		}
+		for offset := 0; offset < len(text); {
+			index := strings.Index(text[offset:], keyword)
+			if index < 0 {
+				break
+			}
+			start := offset + index
+			end := start + len(keyword)
+			if isWordBoundary(text, start-1) && isWordBoundary(text, end) {
+				return true
+			}
+			offset = start + 1
+		}
+	}
+	return false
+}
+
+// isWordBoundary returns true if the byte at index of text is not part of a word
+func isWordBoundary(text string, index int) bool {
+	if index < 0 || index >= len(text) {
+		return true
+	}
+	r := rune(text[index])
+	return r < 0x80 && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// Categorize adds the taxa of every rule the bookmark matches, normalized by the taxonomy settings, and records
+// which rule produced each taxon
+func (b *Bookmark) Categorize(rules []ContentCategorizationRule, cts ContentTaxonomySettings) {
+	for _, rule := range rules {
+		if !rule.Matches(b) {
+			continue
+		}
+		for _, taxon := range rule.Taxa {
+			if added := b.AddTaxon(rule.Taxonomy, taxon, cts); len(added) > 0 {
+				b.addCategorization(Categorization{Taxonomy: rule.Taxonomy, Taxon: added, Rule: rule.Name})
+			}
+		}
+	}
+}
+
+// AddTaxon adds taxon to the bookmark's taxonomy called name unless it's already there and returns the taxon as it
+// was normalized; a hierarchical taxonomy gets the taxon at its root and a missing taxonomy is created as a
+// FlatTaxonomy
+func (b *Bookmark) AddTaxon(name TaxonomyName, taxon TaxonName, cts ContentTaxonomySettings) TaxonName {
+	if taxon = cts.Normalize(name, taxon); len(taxon) == 0 {
+		return taxon
+	}
+	for index, taxonomy := range b.Taxonomies {
+		switch existing := taxonomy.(type) {
+		case FlatTaxonomy:
+			if existing.Name == name {
+				existing.Add(taxon)
+				b.Taxonomies[index] = existing
+				return taxon
+			}
+		case HiearchicalTaxonomy:
+			if existing.Name == name {
+				existing.AddPath(taxon)
+				b.Taxonomies[index] = existing
+				return taxon
+			}
+		}
+	}
+	flat := FlatTaxonomy{Name: name}
+	flat.Add(taxon)
+	b.Taxonomies = append(b.Taxonomies, flat)
+	return taxon
+}
+
+func (b *Bookmark) addCategorization(categorization Categorization) {
+	for _, existing := range b.Categorizations {
+		if existing == categorization {
+			return
+		}
+	}
+	b.Categorizations = append(b.Categorizations, categorization)
+}
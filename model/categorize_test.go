@@ -0,0 +1,152 @@
+package model
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+// testBookmark creates a bookmark of urlText with the title, body and properties, given as name and value pairs
+func testBookmark(t *testing.T, urlText, title, body string, properties ...string) *Bookmark {
+	t.Helper()
+	bookmark := &Bookmark{Title: ContentTitleText(title), Body: ContentBodyText(body)}
+	if len(urlText) > 0 {
+		u, err := url.Parse(urlText)
+		if err != nil {
+			t.Fatalf("invalid URL %q: %v", urlText, err)
+		}
+		bookmark.Link.FinalURL = MakeURL(u)
+	}
+	if len(properties) > 0 {
+		bookmark.Properties = MakeProperties()
+		for i := 0; i+1 < len(properties); i += 2 {
+			bookmark.Properties.Add(PropertyName(properties[i]), properties[i+1])
+		}
+	}
+	return bookmark
+}
+
+func testRegExp(t *testing.T, expression string) *RegularExpression {
+	t.Helper()
+	re, err := MakeRegularExpression(expression)
+	if err != nil {
+		t.Fatalf("invalid expression %q: %v", expression, err)
+	}
+	return re
+}
+
+func TestCategorizationRuleMatches(t *testing.T) {
+	property := PropertyName("og.type")
+	tests := []struct {
+		name     string
+		rule     ContentCategorizationRule
+		bookmark *Bookmark
+		want     bool
+	}{
+		{"no conditions", ContentCategorizationRule{Taxa: []TaxonName{"any"}},
+			testBookmark(t, "https://example.com/", "Title", "Body"), false},
+		{"exact domain", ContentCategorizationRule{Domains: []string{"Example.com"}},
+			testBookmark(t, "https://example.com/story", "", ""), true},
+		{"wildcard domain", ContentCategorizationRule{Domains: []string{"*.example.com"}},
+			testBookmark(t, "https://news.example.com./story", "", ""), true},
+		{"other domain", ContentCategorizationRule{Domains: []string{"example.com"}},
+			testBookmark(t, "https://news.example.com/story", "", ""), false},
+		{"domain without final URL", ContentCategorizationRule{Domains: []string{"example.com"}},
+			testBookmark(t, "", "", ""), false},
+		{"URL expression", ContentCategorizationRule{URLRegExpr: testRegExp(t, `/health/`)},
+			testBookmark(t, "https://example.com/health/story", "", ""), true},
+		{"URL expression doesn't match", ContentCategorizationRule{URLRegExpr: testRegExp(t, `/health/`)},
+			testBookmark(t, "https://example.com/sports/story", "", ""), false},
+		{"title keyword", ContentCategorizationRule{TitleKeywords: []string{"FHIR"}},
+			testBookmark(t, "", "Why fhir matters", ""), true},
+		{"title keyword inside a word", ContentCategorizationRule{TitleKeywords: []string{"AI"}},
+			testBookmark(t, "", "Said the chair", ""), false},
+		{"title phrase", ContentCategorizationRule{TitleKeywords: []string{" machine learning "}},
+			testBookmark(t, "", "Machine-learning and Machine Learning.", ""), true},
+		{"body keyword", ContentCategorizationRule{BodyKeywords: []string{"interop", "hl7"}},
+			testBookmark(t, "", "", "Adopting HL7, finally."), true},
+		{"empty keywords", ContentCategorizationRule{BodyKeywords: []string{" "}},
+			testBookmark(t, "", "", "Anything"), false},
+		{"property exists", ContentCategorizationRule{PropertyName: &property},
+			testBookmark(t, "", "", "", "og.type", "article"), true},
+		{"property value", ContentCategorizationRule{PropertyName: &property, PropertyValueRegExpr: testRegExp(t, `^video`)},
+			testBookmark(t, "", "", "", "og.type", "video.movie"), true},
+		{"property value doesn't match", ContentCategorizationRule{PropertyName: &property, PropertyValueRegExpr: testRegExp(t, `^video`)},
+			testBookmark(t, "", "", "", "og.type", "article"), false},
+		{"property missing", ContentCategorizationRule{PropertyName: &property},
+			testBookmark(t, "", "", ""), false},
+		{"all conditions", ContentCategorizationRule{Domains: []string{"example.com"}, TitleKeywords: []string{"fhir"}, BodyKeywords: []string{"api"}},
+			testBookmark(t, "https://example.com/story", "FHIR", "The API"), true},
+		{"one condition fails", ContentCategorizationRule{Domains: []string{"example.com"}, TitleKeywords: []string{"fhir"}, BodyKeywords: []string{"api"}},
+			testBookmark(t, "https://example.com/story", "FHIR", "No interface"), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.rule.Matches(test.bookmark); got != test.want {
+				t.Errorf("matches %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCategorize(t *testing.T) {
+	cts := ContentTaxonomySettings{Normalizations: []TaxonomyNormalization{
+		{Taxonomy: "topics", FoldCase: true, Synonyms: []TaxonSynonyms{{Preferred: "Interoperability", Synonyms: []TaxonName{"interop"}}}},
+	}}
+	rules := []ContentCategorizationRule{
+		{Name: "health", Domains: []string{"*.example.com"}, Taxonomy: "topics", Taxa: []TaxonName{"Health IT", "interop"}},
+		{Name: "standards", TitleKeywords: []string{"fhir"}, Taxonomy: "topics", Taxa: []TaxonName{"Interop", "Standards"}},
+		{Name: "tagged", TitleKeywords: []string{"fhir"}, Taxonomy: "tags", Taxa: []TaxonName{"FHIR"}},
+		{Name: "unmatched", BodyKeywords: []string{"sports"}, Taxonomy: "topics", Taxa: []TaxonName{"Sports"}},
+	}
+	tests := []struct {
+		name                string
+		bookmark            *Bookmark
+		taxonomies          []Taxonomy
+		wantTaxonomies      []Taxonomy
+		wantCategorizations []Categorization
+	}{
+		{"no rules match", testBookmark(t, "https://other.com/", "Title", "Body"), nil, nil, nil},
+		{"rules add normalized taxa once",
+			testBookmark(t, "https://news.example.com/", "FHIR explained", "Body"), nil,
+			[]Taxonomy{
+				FlatTaxonomy{Name: "topics", Taxa: []TaxonName{"health it", "Interoperability", "standards"}},
+				FlatTaxonomy{Name: "tags", Taxa: []TaxonName{"FHIR"}},
+			},
+			[]Categorization{
+				{Taxonomy: "topics", Taxon: "health it", Rule: "health"},
+				{Taxonomy: "topics", Taxon: "Interoperability", Rule: "health"},
+				{Taxonomy: "topics", Taxon: "Interoperability", Rule: "standards"},
+				{Taxonomy: "topics", Taxon: "standards", Rule: "standards"},
+				{Taxonomy: "tags", Taxon: "FHIR", Rule: "tagged"},
+			}},
+		{"existing hierarchical taxonomy gets root taxa",
+			testBookmark(t, "https://other.com/", "FHIR explained", "Body"),
+			[]Taxonomy{HiearchicalTaxonomy{Name: "tags"}},
+			[]Taxonomy{
+				HiearchicalTaxonomy{Name: "tags", Taxa: []TaxonNode{{Taxon: taxonPointer("FHIR")}}},
+				FlatTaxonomy{Name: "topics", Taxa: []TaxonName{"Interoperability", "standards"}},
+			},
+			[]Categorization{
+				{Taxonomy: "topics", Taxon: "Interoperability", Rule: "standards"},
+				{Taxonomy: "topics", Taxon: "standards", Rule: "standards"},
+				{Taxonomy: "tags", Taxon: "FHIR", Rule: "tagged"},
+			}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.bookmark.Taxonomies = test.taxonomies
+			test.bookmark.Categorize(rules, cts)
+			if !reflect.DeepEqual(test.bookmark.Taxonomies, test.wantTaxonomies) {
+				t.Errorf("got taxonomies %+v, want %+v", test.bookmark.Taxonomies, test.wantTaxonomies)
+			}
+			if !reflect.DeepEqual(test.bookmark.Categorizations, test.wantCategorizations) {
+				t.Errorf("got categorizations %+v, want %+v", test.bookmark.Categorizations, test.wantCategorizations)
+			}
+		})
+	}
+}
+
+func taxonPointer(taxon TaxonName) *TaxonName {
+	return &taxon
+}
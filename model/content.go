@@ -82,6 +82,17 @@ func (t ContentBodyText) FirstSentence() (string, error) {
 	return firstSentenceRegExp.FindString(string(t)), nil
 }
 
+// firstSentence uses the splitter to find the first sentence, the regular expression is used by default
+func (t ContentBodyText) firstSentence(splitter ContentSentenceSplitter) (string, error) {
+	if splitter == ContentSentenceSplitterNlp {
+		if len(t) == 0 {
+			return "", nil
+		}
+		return t.FirstSentenceNLP()
+	}
+	return t.FirstSentence()
+}
+
 func (t ContentBodyText) FirstSentenceNLP() (string, error) {
 	content, proseErr := prose.NewDocument(string(t))
 	if proseErr != nil {
@@ -108,15 +119,28 @@ func (t *ContentSummaryText) UnmarshalGQL(v interface{}) error {
 }
 
 func (t *ContentSummaryText) Edit(obj *Bookmark, settings *ContentSummarySettings) error {
+	var err error
 	switch settings.Policy {
 	case ContentSummaryPolicyAlwaysUseFirstSentenceOfContentBody:
-		fs, _ := obj.Body.FirstSentence()
+		var fs string
+		fs, err = obj.Body.firstSentence(settings.SentenceSplitter)
 		*t = ContentSummaryText(fs)
 	case ContentSummaryPolicyUseFirstSentenceOfContentBodyIfEmpty:
 		if len(*t) == 0 {
-			fs, _ := obj.Body.FirstSentence()
+			var fs string
+			fs, err = obj.Body.firstSentence(settings.SentenceSplitter)
 			*t = ContentSummaryText(fs)
 		}
+	case ContentSummaryPolicyAlwaysUseExtractiveSummaryOfContentBody:
+		var summary string
+		summary, err = obj.Body.ExtractiveSummary(settings)
+		*t = ContentSummaryText(summary)
+	case ContentSummaryPolicyUseExtractiveSummaryOfContentBodyIfEmpty:
+		if len(*t) == 0 {
+			var summary string
+			summary, err = obj.Body.ExtractiveSummary(settings)
+			*t = ContentSummaryText(summary)
+		}
 	}
-	return nil
+	return err
 }
@@ -118,33 +118,39 @@ type AggregateLinkScores struct {
 func (AggregateLinkScores) IsLinkScores() {}
 
 type Bookmark struct {
-	ID         string             `json:"id"`
-	Link       BookmarkLink       `json:"link"`
-	Title      ContentTitleText   `json:"title"`
-	Summary    ContentSummaryText `json:"summary"`
-	Body       ContentBodyText    `json:"body"`
-	Taxonomies []Taxonomy         `json:"taxonomies"`
-	Properties *Properties        `json:"properties"`
-	Scores     LinkScores         `json:"scores"`
+	ID              string             `json:"id"`
+	Link            BookmarkLink       `json:"link"`
+	Title           ContentTitleText   `json:"title"`
+	Summary         ContentSummaryText `json:"summary"`
+	Body            ContentBodyText    `json:"body"`
+	Taxonomies      []Taxonomy         `json:"taxonomies"`
+	Properties      *Properties        `json:"properties"`
+	Scores          LinkScores         `json:"scores"`
+	Categorizations []Categorization   `json:"categorizations"`
 }
 
 func (Bookmark) IsContent() {}
 
 type BookmarkLink struct {
-	ID              string  `json:"id"`
-	OriginalURLText URLText `json:"originalURLText"`
-	FinalURL        *URL    `json:"finalURL"`
-	IsValid         bool    `json:"isValid"`
+	ID              string         `json:"id"`
+	OriginalURLText URLText        `json:"originalURLText"`
+	FinalURL        *URL           `json:"finalURL"`
+	IsValid         bool           `json:"isValid"`
+	Rewrites        []URLRewrite   `json:"rewrites"`
+	CanonicalURL    *URL           `json:"canonicalURL"`
+	RedirectChain   []LinkRedirect `json:"redirectChain"`
 }
 
 func (BookmarkLink) IsLink() {}
 
 type Bookmarks struct {
-	ID         string             `json:"id"`
-	Source     BookmarksAPISource `json:"source"`
-	Content    []Bookmark         `json:"content"`
-	Activities Activities         `json:"activities"`
-	Properties *Properties        `json:"properties"`
+	ID                  string             `json:"id"`
+	Source              BookmarksAPISource `json:"source"`
+	Content             []Bookmark         `json:"content"`
+	Activities          Activities         `json:"activities"`
+	Properties          *Properties        `json:"properties"`
+	SourceLinks         []URLText          `json:"sourceLinks"`
+	SourceLinksComplete bool               `json:"sourceLinksComplete"`
 }
 
 func (Bookmarks) IsContentCollection() {}
@@ -172,11 +178,33 @@ type BookmarksToMarkdownPipelineInput struct {
 	BookmarksURL URLText                   `json:"bookmarksURL"`
 	Settings     SettingsPath              `json:"settings"`
 	Repository   RepositoryName            `json:"repository"`
+	Incremental  bool                      `json:"incremental"`
+	Timeout      *TimeoutDuration          `json:"timeout"`
+}
+
+type Categorization struct {
+	Taxonomy TaxonomyName `json:"taxonomy"`
+	Taxon    TaxonName    `json:"taxon"`
+	Rule     NameText     `json:"rule"`
 }
 
 type ContentBodySettings struct {
-	AllowFrontmatter              bool   `json:"allowFrontmatter"`
-	FrontMatterPropertyNamePrefix string `json:"frontMatterPropertyNamePrefix"`
+	AllowFrontmatter              bool                     `json:"allowFrontmatter"`
+	FrontMatterPropertyNamePrefix string                   `json:"frontMatterPropertyNamePrefix"`
+	ExtractPolicy                 ContentBodyExtractPolicy `json:"extractPolicy"`
+	ExtractMinLength              int                      `json:"extractMinLength"`
+}
+
+type ContentCategorizationRule struct {
+	Name                 NameText           `json:"name"`
+	Domains              []string           `json:"domains"`
+	URLRegExpr           *RegularExpression `json:"urlRegExpr"`
+	TitleKeywords        []string           `json:"titleKeywords"`
+	BodyKeywords         []string           `json:"bodyKeywords"`
+	PropertyName         *PropertyName      `json:"propertyName"`
+	PropertyValueRegExpr *RegularExpression `json:"propertyValueRegExpr"`
+	Taxonomy             TaxonomyName       `json:"taxonomy"`
+	Taxa                 []TaxonName        `json:"taxa"`
 }
 
 type ContentEditActivity struct {
@@ -192,17 +220,47 @@ type ContentEditActivity struct {
 
 func (ContentEditActivity) IsActivity() {}
 
+type ContentKeywordsSettings struct {
+	ExtractKeywords  bool         `json:"extractKeywords"`
+	KeywordsTaxonomy TaxonomyName `json:"keywordsTaxonomy"`
+	ExtractEntities  bool         `json:"extractEntities"`
+	EntitiesTaxonomy TaxonomyName `json:"entitiesTaxonomy"`
+	MaxTerms         int          `json:"maxTerms"`
+	MinFrequency     int          `json:"minFrequency"`
+	StopWords        []string     `json:"stopWords"`
+}
+
+type ContentMetadataSettings struct {
+	FillMissingTitle         bool `json:"fillMissingTitle"`
+	FillMissingSummary       bool `json:"fillMissingSummary"`
+	FillMissingDate          bool `json:"fillMissingDate"`
+	FillMissingFeaturedImage bool `json:"fillMissingFeaturedImage"`
+}
+
 type ContentSettings struct {
-	Store   SettingsStore          `json:"store"`
-	Title   ContentTitleSettings   `json:"title"`
-	Summary ContentSummarySettings `json:"summary"`
-	Body    ContentBodySettings    `json:"body"`
+	Store               SettingsStore               `json:"store"`
+	Title               ContentTitleSettings        `json:"title"`
+	Summary             ContentSummarySettings      `json:"summary"`
+	Body                ContentBodySettings         `json:"body"`
+	Metadata            ContentMetadataSettings     `json:"metadata"`
+	Taxonomies          ContentTaxonomySettings     `json:"taxonomies"`
+	Keywords            ContentKeywordsSettings     `json:"keywords"`
+	CategorizationRules []ContentCategorizationRule `json:"categorizationRules"`
 }
 
 func (ContentSettings) IsPersistentSettings() {}
 
 type ContentSummarySettings struct {
-	Policy ContentSummaryPolicy `json:"policy"`
+	Policy                    ContentSummaryPolicy    `json:"policy"`
+	SentenceSplitter          ContentSentenceSplitter `json:"sentenceSplitter"`
+	ExtractiveSentences       int                     `json:"extractiveSentences"`
+	ExtractiveMaxLength       int                     `json:"extractiveMaxLength"`
+	FallbackToMetaDescription bool                    `json:"fallbackToMetaDescription"`
+}
+
+type ContentTaxonomySettings struct {
+	TagPathDelimiter string                  `json:"tagPathDelimiter"`
+	Normalizations   []TaxonomyNormalization `json:"normalizations"`
 }
 
 type ContentTitleSettings struct {
@@ -273,10 +331,12 @@ type GitHubRepository struct {
 func (GitHubRepository) IsRepository() {}
 
 type HTTPClientSettings struct {
-	Store     SettingsStore   `json:"store"`
-	UserAgent string          `json:"userAgent"`
-	Timeout   TimeoutDuration `json:"timeout"`
-	Cache     HTTPCache       `json:"cache"`
+	Store                SettingsStore   `json:"store"`
+	UserAgent            string          `json:"userAgent"`
+	Timeout              TimeoutDuration `json:"timeout"`
+	Cache                HTTPCache       `json:"cache"`
+	BlockPrivateNetworks bool            `json:"blockPrivateNetworks"`
+	AllowedNetworks      []string        `json:"allowedNetworks"`
 }
 
 func (HTTPClientSettings) IsPersistentSettings() {}
@@ -304,19 +364,60 @@ type HiearchicalTaxonomy struct {
 
 func (HiearchicalTaxonomy) IsTaxonomy() {}
 
+type LinkDomainPolicy struct {
+	Domains                                     []string                     `json:"domains"`
+	TraverseLinks                               *bool                        `json:"traverseLinks"`
+	ScoreLinks                                  *LinkScoresLifecycleSettings `json:"scoreLinks"`
+	FollowRedirectsInLinkDestinationHTMLContent *bool                        `json:"followRedirectsInLinkDestinationHTMLContent"`
+	ParseMetaDataInLinkDestinationHTMLContent   *bool                        `json:"parseMetaDataInLinkDestinationHTMLContent"`
+	DownloadLinkDestinationAttachments          *bool                        `json:"downloadLinkDestinationAttachments"`
+	UserAgent                                   *string                      `json:"userAgent"`
+	Timeout                                     *TimeoutDuration             `json:"timeout"`
+}
+
 type LinkLifecyleSettings struct {
 	Store                                       SettingsStore               `json:"store"`
 	TraverseLinks                               bool                        `json:"traverseLinks"`
 	ScoreLinks                                  LinkScoresLifecycleSettings `json:"scoreLinks"`
 	IgnoreURLsRegExprs                          []*RegularExpression        `json:"ignoreURLsRegExprs"`
+	AllowURLsRegExprs                           []*RegularExpression        `json:"allowURLsRegExprs"`
 	RemoveParamsFromURLsRegEx                   []*RegularExpression        `json:"removeParamsFromURLsRegEx"`
+	RewriteURLRules                             []URLRewriteRule            `json:"rewriteURLRules"`
 	FollowRedirectsInLinkDestinationHTMLContent bool                        `json:"followRedirectsInLinkDestinationHTMLContent"`
 	ParseMetaDataInLinkDestinationHTMLContent   bool                        `json:"parseMetaDataInLinkDestinationHTMLContent"`
 	DownloadLinkDestinationAttachments          bool                        `json:"downloadLinkDestinationAttachments"`
+	MaxConcurrentHarvests                       int                         `json:"maxConcurrentHarvests"`
+	MaxConcurrentHarvestsPerHost                int                         `json:"maxConcurrentHarvestsPerHost"`
+	Retry                                       LinkRetrySettings           `json:"retry"`
+	Robots                                      LinkRobotsSettings          `json:"robots"`
+	DomainPolicies                              []LinkDomainPolicy          `json:"domainPolicies"`
 }
 
 func (LinkLifecyleSettings) IsPersistentSettings() {}
 
+type LinkRedirect struct {
+	URL        URLText           `json:"url"`
+	StatusCode int               `json:"statusCode"`
+	Redirect   *LinkRedirectType `json:"redirect"`
+	Duration   TimeoutDuration   `json:"duration"`
+	Error      *string           `json:"error"`
+}
+
+type LinkRetrySettings struct {
+	MaxAttempts            int             `json:"maxAttempts"`
+	InitialBackoff         TimeoutDuration `json:"initialBackoff"`
+	MaxBackoff             TimeoutDuration `json:"maxBackoff"`
+	RetryStatusCodes       []int           `json:"retryStatusCodes"`
+	CircuitBreakerFailures int             `json:"circuitBreakerFailures"`
+	CircuitBreakerCooldown TimeoutDuration `json:"circuitBreakerCooldown"`
+}
+
+type LinkRobotsSettings struct {
+	HonorRobotsTxt bool            `json:"honorRobotsTxt"`
+	CacheDuration  TimeoutDuration `json:"cacheDuration"`
+	MaxCrawlDelay  TimeoutDuration `json:"maxCrawlDelay"`
+}
+
 type LinkScoresLifecycleSettings struct {
 	Score    bool `json:"score"`
 	Simulate bool `json:"simulate"`
@@ -340,11 +441,17 @@ type LinkedInLinkScores struct {
 func (LinkedInLinkScores) IsLinkScores() {}
 
 type MarkdownGeneratorSettings struct {
-	Store               SettingsStore `json:"store"`
-	CancelOnWriteErrors int           `json:"cancelOnWriteErrors"`
-	ContentPath         string        `json:"contentPath"`
-	ImagesPath          string        `json:"imagesPath"`
-	ImagesURLRel        URLText       `json:"imagesURLRel"`
+	Store                      SettingsStore        `json:"store"`
+	CancelOnWriteErrors        int                  `json:"cancelOnWriteErrors"`
+	ContentPath                string               `json:"contentPath"`
+	ImagesPath                 string               `json:"imagesPath"`
+	ImagesURLRel               URLText              `json:"imagesURLRel"`
+	AttachmentsPath            string               `json:"attachmentsPath"`
+	AttachmentsURLRel          URLText              `json:"attachmentsURLRel"`
+	SyncStatePath              string               `json:"syncStatePath"`
+	DeletedContentPolicy       DeletedContentPolicy `json:"deletedContentPolicy"`
+	ArchivePath                string               `json:"archivePath"`
+	RedirectChainInFrontMatter bool                 `json:"redirectChainInFrontMatter"`
 }
 
 func (MarkdownGeneratorSettings) IsPersistentSettings() {}
@@ -372,6 +479,11 @@ type Properties struct {
 	All []Property `json:"all"`
 }
 
+type RegisteredSource struct {
+	Name       NameText           `json:"name"`
+	URLPattern *RegularExpression `json:"urlPattern"`
+}
+
 type Repositories struct {
 	Store SettingsStore `json:"store"`
 	All   []Repository  `json:"all"`
@@ -390,11 +502,42 @@ type SettingsStore struct {
 	Name SettingsStoreName `json:"name"`
 }
 
+type SourceSettings struct {
+	Store              SettingsStore        `json:"store"`
+	OutlinePolicy      SourceOutlinePolicy  `json:"outlinePolicy"`
+	Columns            TabularColumnMapping `json:"columns"`
+	LocalFilesRootPath string               `json:"localFilesRootPath"`
+	AllowStdin         bool                 `json:"allowStdin"`
+}
+
+func (SourceSettings) IsPersistentSettings() {}
+
+type TabularColumnMapping struct {
+	URL           string `json:"url"`
+	Title         string `json:"title"`
+	Tags          string `json:"tags"`
+	TagsDelimiter string `json:"tagsDelimiter"`
+	Date          string `json:"date"`
+}
+
 type TaxonNode struct {
 	Taxon *TaxonName  `json:"taxon"`
 	Taxa  []TaxonNode `json:"taxa"`
 }
 
+type TaxonSynonyms struct {
+	Preferred TaxonName   `json:"preferred"`
+	Synonyms  []TaxonName `json:"synonyms"`
+}
+
+type TaxonomyNormalization struct {
+	Taxonomy        TaxonomyName    `json:"taxonomy"`
+	FoldCase        bool            `json:"foldCase"`
+	Slug            bool            `json:"slug"`
+	Synonyms        []TaxonSynonyms `json:"synonyms"`
+	WarnUnknownTaxa bool            `json:"warnUnknownTaxa"`
+}
+
 type TempFileRepository struct {
 	Name   RepositoryName `json:"name"`
 	URL    URLText        `json:"url"`
@@ -410,21 +553,122 @@ type TextProperty struct {
 
 func (TextProperty) IsProperty() {}
 
+type URLRewrite struct {
+	Rule NameText `json:"rule"`
+	From URLText  `json:"from"`
+	To   URLText  `json:"to"`
+}
+
+type URLRewriteRule struct {
+	Name        NameText           `json:"name"`
+	Type        URLRewriteRuleType `json:"type"`
+	Match       *RegularExpression `json:"match"`
+	Replacement *string            `json:"replacement"`
+}
+
+type ContentBodyExtractPolicy string
+
+const (
+	ContentBodyExtractPolicyNever          ContentBodyExtractPolicy = "Never"
+	ContentBodyExtractPolicyExtractIfEmpty ContentBodyExtractPolicy = "ExtractIfEmpty"
+	ContentBodyExtractPolicyAlwaysExtract  ContentBodyExtractPolicy = "AlwaysExtract"
+)
+
+var AllContentBodyExtractPolicy = []ContentBodyExtractPolicy{
+	ContentBodyExtractPolicyNever,
+	ContentBodyExtractPolicyExtractIfEmpty,
+	ContentBodyExtractPolicyAlwaysExtract,
+}
+
+func (e ContentBodyExtractPolicy) IsValid() bool {
+	switch e {
+	case ContentBodyExtractPolicyNever, ContentBodyExtractPolicyExtractIfEmpty, ContentBodyExtractPolicyAlwaysExtract:
+		return true
+	}
+	return false
+}
+
+func (e ContentBodyExtractPolicy) String() string {
+	return string(e)
+}
+
+func (e *ContentBodyExtractPolicy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ContentBodyExtractPolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ContentBodyExtractPolicy", str)
+	}
+	return nil
+}
+
+func (e ContentBodyExtractPolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ContentSentenceSplitter string
+
+const (
+	ContentSentenceSplitterRegEx ContentSentenceSplitter = "RegEx"
+	ContentSentenceSplitterNlp   ContentSentenceSplitter = "NLP"
+)
+
+var AllContentSentenceSplitter = []ContentSentenceSplitter{
+	ContentSentenceSplitterRegEx,
+	ContentSentenceSplitterNlp,
+}
+
+func (e ContentSentenceSplitter) IsValid() bool {
+	switch e {
+	case ContentSentenceSplitterRegEx, ContentSentenceSplitterNlp:
+		return true
+	}
+	return false
+}
+
+func (e ContentSentenceSplitter) String() string {
+	return string(e)
+}
+
+func (e *ContentSentenceSplitter) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ContentSentenceSplitter(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ContentSentenceSplitter", str)
+	}
+	return nil
+}
+
+func (e ContentSentenceSplitter) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ContentSummaryPolicy string
 
 const (
-	ContentSummaryPolicyAlwaysUseFirstSentenceOfContentBody  ContentSummaryPolicy = "AlwaysUseFirstSentenceOfContentBody"
-	ContentSummaryPolicyUseFirstSentenceOfContentBodyIfEmpty ContentSummaryPolicy = "UseFirstSentenceOfContentBodyIfEmpty"
+	ContentSummaryPolicyAlwaysUseFirstSentenceOfContentBody      ContentSummaryPolicy = "AlwaysUseFirstSentenceOfContentBody"
+	ContentSummaryPolicyUseFirstSentenceOfContentBodyIfEmpty     ContentSummaryPolicy = "UseFirstSentenceOfContentBodyIfEmpty"
+	ContentSummaryPolicyAlwaysUseExtractiveSummaryOfContentBody  ContentSummaryPolicy = "AlwaysUseExtractiveSummaryOfContentBody"
+	ContentSummaryPolicyUseExtractiveSummaryOfContentBodyIfEmpty ContentSummaryPolicy = "UseExtractiveSummaryOfContentBodyIfEmpty"
 )
 
 var AllContentSummaryPolicy = []ContentSummaryPolicy{
 	ContentSummaryPolicyAlwaysUseFirstSentenceOfContentBody,
 	ContentSummaryPolicyUseFirstSentenceOfContentBodyIfEmpty,
+	ContentSummaryPolicyAlwaysUseExtractiveSummaryOfContentBody,
+	ContentSummaryPolicyUseExtractiveSummaryOfContentBodyIfEmpty,
 }
 
 func (e ContentSummaryPolicy) IsValid() bool {
 	switch e {
-	case ContentSummaryPolicyAlwaysUseFirstSentenceOfContentBody, ContentSummaryPolicyUseFirstSentenceOfContentBodyIfEmpty:
+	case ContentSummaryPolicyAlwaysUseFirstSentenceOfContentBody, ContentSummaryPolicyUseFirstSentenceOfContentBodyIfEmpty, ContentSummaryPolicyAlwaysUseExtractiveSummaryOfContentBody, ContentSummaryPolicyUseExtractiveSummaryOfContentBodyIfEmpty:
 		return true
 	}
 	return false
@@ -492,6 +736,92 @@ func (e ContentTitleSuffixPolicy) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type DeletedContentPolicy string
+
+const (
+	DeletedContentPolicyIgnore    DeletedContentPolicy = "Ignore"
+	DeletedContentPolicyDelete    DeletedContentPolicy = "Delete"
+	DeletedContentPolicyArchive   DeletedContentPolicy = "Archive"
+	DeletedContentPolicyMarkDraft DeletedContentPolicy = "MarkDraft"
+)
+
+var AllDeletedContentPolicy = []DeletedContentPolicy{
+	DeletedContentPolicyIgnore,
+	DeletedContentPolicyDelete,
+	DeletedContentPolicyArchive,
+	DeletedContentPolicyMarkDraft,
+}
+
+func (e DeletedContentPolicy) IsValid() bool {
+	switch e {
+	case DeletedContentPolicyIgnore, DeletedContentPolicyDelete, DeletedContentPolicyArchive, DeletedContentPolicyMarkDraft:
+		return true
+	}
+	return false
+}
+
+func (e DeletedContentPolicy) String() string {
+	return string(e)
+}
+
+func (e *DeletedContentPolicy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DeletedContentPolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DeletedContentPolicy", str)
+	}
+	return nil
+}
+
+func (e DeletedContentPolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type LinkRedirectType string
+
+const (
+	LinkRedirectTypeHTTP        LinkRedirectType = "HTTP"
+	LinkRedirectTypeMetaRefresh LinkRedirectType = "MetaRefresh"
+)
+
+var AllLinkRedirectType = []LinkRedirectType{
+	LinkRedirectTypeHTTP,
+	LinkRedirectTypeMetaRefresh,
+}
+
+func (e LinkRedirectType) IsValid() bool {
+	switch e {
+	case LinkRedirectTypeHTTP, LinkRedirectTypeMetaRefresh:
+		return true
+	}
+	return false
+}
+
+func (e LinkRedirectType) String() string {
+	return string(e)
+}
+
+func (e *LinkRedirectType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LinkRedirectType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LinkRedirectType", str)
+	}
+	return nil
+}
+
+func (e LinkRedirectType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type PipelineExecutionStrategy string
 
 const (
@@ -575,3 +905,93 @@ func (e *ProgressReporterType) UnmarshalGQL(v interface{}) error {
 func (e ProgressReporterType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type SourceOutlinePolicy string
+
+const (
+	SourceOutlinePolicyBookmarkWebsites SourceOutlinePolicy = "BookmarkWebsites"
+	SourceOutlinePolicyHarvestFeeds     SourceOutlinePolicy = "HarvestFeeds"
+)
+
+var AllSourceOutlinePolicy = []SourceOutlinePolicy{
+	SourceOutlinePolicyBookmarkWebsites,
+	SourceOutlinePolicyHarvestFeeds,
+}
+
+func (e SourceOutlinePolicy) IsValid() bool {
+	switch e {
+	case SourceOutlinePolicyBookmarkWebsites, SourceOutlinePolicyHarvestFeeds:
+		return true
+	}
+	return false
+}
+
+func (e SourceOutlinePolicy) String() string {
+	return string(e)
+}
+
+func (e *SourceOutlinePolicy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SourceOutlinePolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SourceOutlinePolicy", str)
+	}
+	return nil
+}
+
+func (e SourceOutlinePolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type URLRewriteRuleType string
+
+const (
+	URLRewriteRuleTypeRegExReplace    URLRewriteRuleType = "RegExReplace"
+	URLRewriteRuleTypeReplaceHost     URLRewriteRuleType = "ReplaceHost"
+	URLRewriteRuleTypeAMPToCanonical  URLRewriteRuleType = "AMPToCanonical"
+	URLRewriteRuleTypeForceHTTPS      URLRewriteRuleType = "ForceHTTPS"
+	URLRewriteRuleTypeStripFragment   URLRewriteRuleType = "StripFragment"
+	URLRewriteRuleTypeSortQueryParams URLRewriteRuleType = "SortQueryParams"
+)
+
+var AllURLRewriteRuleType = []URLRewriteRuleType{
+	URLRewriteRuleTypeRegExReplace,
+	URLRewriteRuleTypeReplaceHost,
+	URLRewriteRuleTypeAMPToCanonical,
+	URLRewriteRuleTypeForceHTTPS,
+	URLRewriteRuleTypeStripFragment,
+	URLRewriteRuleTypeSortQueryParams,
+}
+
+func (e URLRewriteRuleType) IsValid() bool {
+	switch e {
+	case URLRewriteRuleTypeRegExReplace, URLRewriteRuleTypeReplaceHost, URLRewriteRuleTypeAMPToCanonical, URLRewriteRuleTypeForceHTTPS, URLRewriteRuleTypeStripFragment, URLRewriteRuleTypeSortQueryParams:
+		return true
+	}
+	return false
+}
+
+func (e URLRewriteRuleType) String() string {
+	return string(e)
+}
+
+func (e *URLRewriteRuleType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = URLRewriteRuleType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid URLRewriteRuleType", str)
+	}
+	return nil
+}
+
+func (e URLRewriteRuleType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
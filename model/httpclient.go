@@ -13,34 +13,45 @@ import (
 // NewHTTPClient uses the settings to create a new, thread-safe and reusable, HTTP Client.
 // The resulting http.Client will always be a valid, even if there's an error.
 func (hcs HTTPClientSettings) NewHTTPClient() (*http.Client, error) {
+	transport, transportErr := hcs.newTransport()
+	client, err := hcs.newHTTPClient(transport)
+	if err == nil {
+		err = transportErr
+	}
+	return client, err
+}
+
+func (hcs HTTPClientSettings) newHTTPClient(transport http.RoundTripper) (*http.Client, error) {
 	if hcs.Cache == nil {
-		return hcs.newDefaultHTTPClient(), nil
+		return hcs.newDefaultHTTPClient(transport), nil
 	}
 
 	switch cache := hcs.Cache.(type) {
 	case *HTTPDiskCache:
-		return hcs.newHTTPDiskCacheClient(cache)
+		return hcs.newHTTPDiskCacheClient(cache, transport)
 	case *HTTPMemoryCache:
-		return hcs.newHTTPMemoryCacheClient(cache)
+		return hcs.newHTTPMemoryCacheClient(cache, transport)
 	default:
-		return hcs.newDefaultHTTPClient(), fmt.Errorf("Unknown cache type %T in HTTPClientSettings.NewHTTPClient()", hcs.Cache)
+		return hcs.newDefaultHTTPClient(transport), fmt.Errorf("Unknown cache type %T in HTTPClientSettings.NewHTTPClient()", hcs.Cache)
 	}
 }
 
-func (hcs HTTPClientSettings) newDefaultHTTPClient() *http.Client {
-	return &http.Client{Timeout: time.Duration(hcs.Timeout)}
+func (hcs HTTPClientSettings) newDefaultHTTPClient(transport http.RoundTripper) *http.Client {
+	return &http.Client{Transport: transport, Timeout: time.Duration(hcs.Timeout)}
 }
 
-func (hcs HTTPClientSettings) newHTTPMemoryCacheClient(cache *HTTPMemoryCache) (*http.Client, error) {
+func (hcs HTTPClientSettings) newHTTPMemoryCacheClient(cache *HTTPMemoryCache, transport http.RoundTripper) (*http.Client, error) {
 	fmt.Println("Using built-in httpcache.MemoryCache for HTTPClient cache")
-	return &http.Client{Transport: httpcache.NewTransport(httpcache.NewMemoryCache()), Timeout: time.Duration(hcs.Timeout)}, nil
+	cacheTransport := httpcache.NewTransport(httpcache.NewMemoryCache())
+	cacheTransport.Transport = transport
+	return &http.Client{Transport: cacheTransport, Timeout: time.Duration(hcs.Timeout)}, nil
 }
 
-func (hcs HTTPClientSettings) newHTTPDiskCacheClient(cache *HTTPDiskCache) (*http.Client, error) {
+func (hcs HTTPClientSettings) newHTTPDiskCacheClient(cache *HTTPDiskCache, transport http.RoundTripper) (*http.Client, error) {
 	cacheDir, err := filepath.Abs(cache.BasePath)
 	if err != nil {
 		cache.Activities.AddError("HTTPClientSettings", "HHTTPC-0001", fmt.Sprintf("%q is not a valid BasePath: %s", cache.BasePath, err.Error()))
-		return hcs.newDefaultHTTPClient(), err
+		return hcs.newDefaultHTTPClient(transport), err
 	}
 
 	if _, err = os.Stat(cacheDir); os.IsNotExist(err) {
@@ -48,7 +59,7 @@ func (hcs HTTPClientSettings) newHTTPDiskCacheClient(cache *HTTPDiskCache) (*htt
 			err = os.MkdirAll(cacheDir, os.FileMode(0755))
 			if err != nil {
 				cache.Activities.AddError("HTTPClientSettings", "HHTTPC-0002", fmt.Sprintf("Unable to create BasePath %q: %s", cacheDir, err.Error()))
-				return &http.Client{Timeout: time.Duration(hcs.Timeout)}, err
+				return hcs.newDefaultHTTPClient(transport), err
 			}
 			cache.Activities.AddHistory(&ActivityLog{Message: ActivityHumanMessage(fmt.Sprintf("Created HTTPDiskCache with BasePath %q", cacheDir))})
 		}
@@ -66,5 +77,7 @@ func (hcs HTTPClientSettings) newHTTPDiskCacheClient(cache *HTTPDiskCache) (*htt
 		}
 	}
 
-	return &http.Client{Transport: httpcache.NewTransport(httpCache), Timeout: time.Duration(hcs.Timeout)}, nil
+	cacheTransport := httpcache.NewTransport(httpCache)
+	cacheTransport.Transport = transport
+	return &http.Client{Transport: cacheTransport, Timeout: time.Duration(hcs.Timeout)}, nil
 }
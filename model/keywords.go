@@ -0,0 +1,180 @@
+package model
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"gopkg.in/jdkato/prose.v2"
+)
+
+var markdownLinkRegExp = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`) // Matches [text](url) and ![alt](src) so only the text is analyzed
+var bareURLRegExp = regexp.MustCompile(`https?://\S+`)
+
+// defaultStopWords are never keywords on their own, ContentKeywordsSettings StopWords are added to them
+var defaultStopWords = map[string]bool{
+	"a": true, "about": true, "after": true, "all": true, "also": true, "an": true, "and": true, "any": true,
+	"are": true, "as": true, "at": true, "be": true, "because": true, "been": true, "before": true, "being": true,
+	"between": true, "both": true, "but": true, "by": true, "can": true, "could": true, "day": true, "did": true,
+	"do": true, "does": true, "each": true, "even": true, "few": true, "first": true, "for": true, "from": true,
+	"had": true, "has": true, "have": true, "he": true, "her": true, "here": true, "his": true, "how": true,
+	"i": true, "if": true, "in": true, "into": true, "is": true, "it": true, "its": true, "just": true,
+	"last": true, "lot": true, "many": true, "may": true, "more": true, "most": true, "much": true, "new": true,
+	"no": true, "not": true, "now": true, "of": true, "on": true, "one": true, "only": true, "or": true,
+	"other": true, "our": true, "out": true, "over": true, "part": true, "people": true, "same": true,
+	"she": true, "should": true, "so": true, "some": true, "such": true, "than": true, "that": true, "the": true,
+	"their": true, "them": true, "then": true, "there": true, "these": true, "they": true, "thing": true,
+	"things": true, "this": true, "those": true, "through": true, "time": true, "times": true, "to": true,
+	"up": true, "us": true, "use": true, "very": true, "was": true, "way": true, "we": true, "were": true,
+	"what": true, "when": true, "where": true, "which": true, "while": true, "who": true, "why": true,
+	"will": true, "with": true, "would": true, "year": true, "years": true, "you": true, "your": true,
+}
+
+// maxNounPhraseWords limits the length of the noun phrases counted as keywords
+const maxNounPhraseWords = 3
+
+// termCount counts the occurrences of a keyword or entity, keeping the form it first appeared in
+type termCount struct {
+	text  string
+	count int
+	first int
+}
+
+type termCounts struct {
+	terms map[string]*termCount
+	next  int
+}
+
+func (tc *termCounts) add(text string) {
+	key := strings.ToLower(text)
+	if tc.terms == nil {
+		tc.terms = make(map[string]*termCount)
+	}
+	if term, ok := tc.terms[key]; ok {
+		term.count++
+		return
+	}
+	tc.terms[key] = &termCount{text: text, count: 1, first: tc.next}
+	tc.next++
+}
+
+// top returns up to max of the terms occurring at least minFrequency times, most frequent first and in the order
+// they first appeared when they're as frequent
+func (tc *termCounts) top(max, minFrequency int) []string {
+	var terms []*termCount
+	for _, term := range tc.terms {
+		if term.count >= minFrequency {
+			terms = append(terms, term)
+		}
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if terms[i].count != terms[j].count {
+			return terms[i].count > terms[j].count
+		}
+		return terms[i].first < terms[j].first
+	})
+	if max > 0 && len(terms) > max {
+		terms = terms[:max]
+	}
+	result := make([]string, len(terms))
+	for i, term := range terms {
+		result[i] = term.text
+	}
+	return result
+}
+
+// ExtractKeywords runs noun phrase and named entity extraction over the bookmark's title, summary and body and
+// adds the most frequent terms to the keywords and entities taxonomies, when the settings ask for them
+func (b *Bookmark) ExtractKeywords(settings *ContentKeywordsSettings, cts ContentTaxonomySettings) error {
+	if !settings.ExtractKeywords && !settings.ExtractEntities {
+		return nil
+	}
+
+	text := strings.Join([]string{string(b.Title), string(b.Summary), string(b.Body)}, ".\n\n")
+	text = markdownLinkRegExp.ReplaceAllString(text, "$1")
+	text = bareURLRegExp.ReplaceAllString(text, "")
+	doc, proseErr := prose.NewDocument(text)
+	if proseErr != nil {
+		return proseErr
+	}
+
+	stopWords := make(map[string]bool, len(defaultStopWords)+len(settings.StopWords))
+	for word := range defaultStopWords {
+		stopWords[word] = true
+	}
+	for _, word := range settings.StopWords {
+		stopWords[strings.ToLower(strings.TrimSpace(word))] = true
+	}
+
+	if settings.ExtractKeywords {
+		var keywords termCounts
+		for _, phrase := range nounPhrases(doc.Tokens(), stopWords) {
+			keywords.add(phrase)
+		}
+		for _, keyword := range keywords.top(settings.MaxTerms, settings.MinFrequency) {
+			b.AddTaxon(settings.KeywordsTaxonomy, TaxonName(keyword), cts)
+		}
+	}
+
+	if settings.ExtractEntities {
+		var entities termCounts
+		for _, entity := range doc.Entities() {
+			if name := strings.TrimSpace(entity.Text); len(name) > 1 && !stopWords[strings.ToLower(name)] {
+				entities.add(name)
+			}
+		}
+		for _, entity := range entities.top(settings.MaxTerms, settings.MinFrequency) {
+			b.AddTaxon(settings.EntitiesTaxonomy, TaxonName(entity), cts)
+		}
+	}
+	return nil
+}
+
+// nounPhrases returns the runs of adjectives and nouns which end in a noun, up to maxNounPhraseWords long;
+// stop words and words which aren't mostly letters break the runs
+func nounPhrases(tokens []prose.Token, stopWords map[string]bool) []string {
+	var result []string
+	var words []string
+	var tags []string
+	flush := func() {
+		// adjectives at the end of a run don't belong to the phrase
+		for len(tags) > 0 && !strings.HasPrefix(tags[len(tags)-1], "NN") {
+			words = words[:len(words)-1]
+			tags = tags[:len(tags)-1]
+		}
+		if len(words) > 0 {
+			result = append(result, strings.Join(words, " "))
+		}
+		words = words[:0]
+		tags = tags[:0]
+	}
+	for _, token := range tokens {
+		isCandidate := (strings.HasPrefix(token.Tag, "NN") || strings.HasPrefix(token.Tag, "JJ")) &&
+			len(token.Text) > 2 && isWordText(token.Text) && !stopWords[strings.ToLower(token.Text)]
+		if !isCandidate {
+			flush()
+			continue
+		}
+		if len(words) == maxNounPhraseWords {
+			flush()
+		}
+		words = append(words, token.Text)
+		tags = append(tags, token.Tag)
+	}
+	flush()
+	return result
+}
+
+// isWordText returns true if text starts with a letter and only contains letters, digits, hyphens and apostrophes
+func isWordText(text string) bool {
+	for index, r := range text {
+		if index == 0 && !unicode.IsLetter(r) {
+			return false
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '\'' {
+			return false
+		}
+	}
+	return true
+}
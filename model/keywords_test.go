@@ -0,0 +1,107 @@
+package model
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/jdkato/prose.v2"
+)
+
+// taggedTokens converts "text/TAG" pairs into tokens
+func taggedTokens(tagged string) []prose.Token {
+	var result []prose.Token
+	for _, pair := range strings.Fields(tagged) {
+		separator := strings.LastIndex(pair, "/")
+		result = append(result, prose.Token{Text: pair[:separator], Tag: pair[separator+1:]})
+	}
+	return result
+}
+
+func TestNounPhrases(t *testing.T) {
+	stopWords := map[string]bool{"thing": true}
+	tests := []struct {
+		name   string
+		tagged string
+		want   []string
+	}{
+		{"single nouns", "The/DT patient/NN records/NNS", []string{"patient records"}},
+		{"adjective and noun", "a/DT clinical/JJ trial/NN ended/VBD", []string{"clinical trial"}},
+		{"trailing adjectives dropped", "records/NNS are/VBP interoperable/JJ", []string{"records"}},
+		{"only adjectives", "very/RB large/JJ", nil},
+		{"broken by verbs", "hospitals/NNS share/VBP health/NN data/NNS", []string{"hospitals", "health data"}},
+		{"limited to three words", "electronic/JJ health/NN record/NN systems/NNS vendors/NNS", []string{"electronic health record", "systems vendors"}},
+		{"stop words break runs", "big/JJ thing/NN design/NN", []string{"design"}},
+		{"short words break runs", "an/DT AI/NN model/NN", []string{"model"}},
+		{"numbers and URLs break runs", "2019/CD report/NN example.com/NN data/NNS", []string{"report", "data"}},
+		{"hyphens and apostrophes", "state-of-the-art/JJ patient's/NN portal/NN", []string{"state-of-the-art patient's portal"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := nounPhrases(taggedTokens(test.tagged), stopWords); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestIsWordText(t *testing.T) {
+	tests := []struct {
+		text string
+		want bool
+	}{
+		{"health", true},
+		{"COVID-19", true},
+		{"patient's", true},
+		{"Zürich", true},
+		{"19th", false},
+		{"-prefix", false},
+		{"example.com", false},
+		{"a_b", false},
+		{"", true},
+	}
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			if got := isWordText(test.text); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestTermCountsTop(t *testing.T) {
+	terms := []string{"FHIR", "patients", "fhir", "HL7", "Patients", "FHIR", "records", "hl7"}
+	tests := []struct {
+		name         string
+		max          int
+		minFrequency int
+		want         []string
+	}{
+		{"most frequent first, then first seen", 0, 0, []string{"FHIR", "patients", "HL7", "records"}},
+		{"limited", 2, 0, []string{"FHIR", "patients"}},
+		{"minimum frequency", 0, 2, []string{"FHIR", "patients", "HL7"}},
+		{"minimum frequency and limit", 1, 3, []string{"FHIR"}},
+		{"none frequent enough", 0, 4, []string{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var counts termCounts
+			for _, term := range terms {
+				counts.add(term)
+			}
+			if got := counts.top(test.max, test.minFrequency); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestExtractKeywordsDisabled(t *testing.T) {
+	bookmark := &Bookmark{Title: "Interoperability", Body: "FHIR and HL7 interoperability"}
+	if err := bookmark.ExtractKeywords(&ContentKeywordsSettings{KeywordsTaxonomy: "keywords", EntitiesTaxonomy: "entities"}, ContentTaxonomySettings{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(bookmark.Taxonomies) > 0 {
+		t.Errorf("got taxonomies %+v without extraction", bookmark.Taxonomies)
+	}
+}
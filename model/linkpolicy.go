@@ -0,0 +1,66 @@
+package model
+
+import (
+	"strings"
+)
+
+// DomainPolicy returns the policy whose domains best match host, or nil if no policy matches; an exact domain
+// such as example.com wins over a wildcard such as *.example.com and longer wildcards win over shorter ones
+func (lls LinkLifecyleSettings) DomainPolicy(host string) *LinkDomainPolicy {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	var result *LinkDomainPolicy
+	var best domainMatch
+	for index := range lls.DomainPolicies {
+		policy := &lls.DomainPolicies[index]
+		for _, domain := range policy.Domains {
+			if match, ok := matchDomain(strings.ToLower(domain), host); ok && (result == nil || match.moreSpecificThan(best)) {
+				result = policy
+				best = match
+			}
+		}
+	}
+	return result
+}
+
+// TraversesAnyLinks returns true if links are traversed by default or by any domain policy
+func (lls LinkLifecyleSettings) TraversesAnyLinks() bool {
+	if lls.TraverseLinks {
+		return true
+	}
+	for _, policy := range lls.DomainPolicies {
+		if policy.TraverseLinks != nil && *policy.TraverseLinks {
+			return true
+		}
+	}
+	return false
+}
+
+// domainMatch describes how a domain matched a host
+type domainMatch struct {
+	exact        bool // the domain is the host itself rather than a wildcard
+	suffixLength int  // the length of the wildcard's suffix, such as 12 for ".example.com"
+}
+
+// moreSpecificThan returns true if m should win over other; exact matches always win over wildcards and longer
+// wildcard suffixes win over shorter ones
+func (m domainMatch) moreSpecificThan(other domainMatch) bool {
+	if m.exact != other.exact {
+		return m.exact
+	}
+	return m.suffixLength > other.suffixLength
+}
+
+// matchDomain returns how domain matches host and false if it doesn't match
+func matchDomain(domain, host string) (domainMatch, bool) {
+	if strings.HasPrefix(domain, "*.") {
+		suffix := domain[1:]
+		if strings.HasSuffix(host, suffix) {
+			return domainMatch{suffixLength: len(suffix)}, true
+		}
+		return domainMatch{}, false
+	}
+	if domain == host {
+		return domainMatch{exact: true, suffixLength: len(domain)}, true
+	}
+	return domainMatch{}, false
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestMatchDomain(t *testing.T) {
+	tests := []struct {
+		domain string
+		host   string
+		want   domainMatch
+		ok     bool
+	}{
+		{"example.com", "example.com", domainMatch{exact: true, suffixLength: 11}, true},
+		{"example.com", "www.example.com", domainMatch{}, false},
+		{"*.example.com", "www.example.com", domainMatch{suffixLength: 12}, true},
+		{"*.example.com", "a.b.example.com", domainMatch{suffixLength: 12}, true},
+		{"*.example.com", "example.com", domainMatch{}, false},
+		{"*.example.com", "badexample.com", domainMatch{}, false},
+		{"example.com", "example.org", domainMatch{}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.domain+" "+test.host, func(t *testing.T) {
+			match, ok := matchDomain(test.domain, test.host)
+			if match != test.want || ok != test.ok {
+				t.Errorf("got %+v, %v, want %+v, %v", match, ok, test.want, test.ok)
+			}
+		})
+	}
+}
+
+func TestDomainPolicy(t *testing.T) {
+	settings := LinkLifecyleSettings{DomainPolicies: []LinkDomainPolicy{
+		{Domains: []string{"*.com"}},
+		{Domains: []string{"*.example.com"}},
+		{Domains: []string{"*.news.example.com"}},
+		// a very long wildcard must not win over a short exact domain
+		{Domains: []string{"*.a-very-long-subdomain-name-that-is-longer-than-the-exact-domain.example.com", "x.example.com"}},
+		{Domains: []string{"y.example.com"}},
+	}}
+	tests := []struct {
+		host string
+		want int
+	}{
+		{"other.com", 0},
+		{"www.example.com", 1},
+		{"www.news.example.com", 2},
+		{"x.example.com", 3},
+		{"WWW.A-VERY-LONG-SUBDOMAIN-NAME-THAT-IS-LONGER-THAN-THE-EXACT-DOMAIN.EXAMPLE.COM.", 3},
+		{"y.example.com", 4},
+		{"example.org", -1},
+	}
+	for _, test := range tests {
+		t.Run(test.host, func(t *testing.T) {
+			policy := settings.DomainPolicy(test.host)
+			switch {
+			case test.want < 0 && policy != nil:
+				t.Errorf("got policy %+v, want none", policy)
+			case test.want >= 0 && policy != &settings.DomainPolicies[test.want]:
+				t.Errorf("got policy %+v, want %+v", policy, settings.DomainPolicies[test.want])
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/Machiel/slugify"
+)
+
+// Normalization returns the TaxonomyNormalization of the taxonomy called name, or nil if it has none
+func (cts ContentTaxonomySettings) Normalization(name TaxonomyName) *TaxonomyNormalization {
+	for index := range cts.Normalizations {
+		if cts.Normalizations[index].Taxonomy == name {
+			return &cts.Normalizations[index]
+		}
+	}
+	return nil
+}
+
+// Normalize returns taxon normalized by the TaxonomyNormalization of the taxonomy called name, taxa of taxonomies
+// without one are returned as they are
+func (cts ContentTaxonomySettings) Normalize(name TaxonomyName, taxon TaxonName) TaxonName {
+	if normalization := cts.Normalization(name); normalization != nil {
+		return normalization.Normalize(taxon)
+	}
+	return taxon
+}
+
+// UnknownTaxa returns the name of taxonomy and its taxa which are not in the controlled vocabulary of its
+// normalization's synonyms, there are none if the normalization doesn't warn about unknown taxa
+func (cts ContentTaxonomySettings) UnknownTaxa(taxonomy Taxonomy) (TaxonomyName, []TaxonName) {
+	var name TaxonomyName
+	var taxa []TaxonName
+	switch t := taxonomy.(type) {
+	case FlatTaxonomy:
+		name = t.Name
+		taxa = t.Taxa
+	case HiearchicalTaxonomy:
+		name = t.Name
+		var walk func(nodes []TaxonNode)
+		walk = func(nodes []TaxonNode) {
+			for _, node := range nodes {
+				if node.Taxon != nil {
+					taxa = append(taxa, *node.Taxon)
+				}
+				walk(node.Taxa)
+			}
+		}
+		walk(t.Taxa)
+	}
+
+	normalization := cts.Normalization(name)
+	if normalization == nil || !normalization.WarnUnknownTaxa {
+		return name, nil
+	}
+	var result []TaxonName
+	for _, taxon := range taxa {
+		if _, known := normalization.preferred(taxon); !known {
+			result = append(result, taxon)
+		}
+	}
+	return name, result
+}
+
+// Normalize returns the preferred label of the synonyms taxon belongs to or, when it isn't a known synonym, the
+// taxon with its case folded and slugged according to the normalization
+func (tn TaxonomyNormalization) Normalize(taxon TaxonName) TaxonName {
+	taxon = TaxonName(strings.TrimSpace(string(taxon)))
+	if preferred, known := tn.preferred(taxon); known {
+		return preferred
+	}
+	if tn.FoldCase {
+		taxon = TaxonName(strings.ToLower(string(taxon)))
+	}
+	if tn.Slug {
+		taxon = TaxonName(slugify.Slugify(string(taxon)))
+	}
+	return taxon
+}
+
+// preferred finds the synonyms taxon belongs to, ignoring case and the differences slugging removes, so that
+// "AI", "ai" and "artificial-intelligence" can all be listed once
+func (tn TaxonomyNormalization) preferred(taxon TaxonName) (TaxonName, bool) {
+	key := taxonKey(taxon)
+	if len(key) == 0 {
+		return taxon, false
+	}
+	for _, synonyms := range tn.Synonyms {
+		if taxonKey(synonyms.Preferred) == key {
+			return synonyms.Preferred, true
+		}
+		for _, synonym := range synonyms.Synonyms {
+			if taxonKey(synonym) == key {
+				return synonyms.Preferred, true
+			}
+		}
+	}
+	return taxon, false
+}
+
+func taxonKey(taxon TaxonName) string {
+	return slugify.Slugify(strings.ToLower(strings.TrimSpace(string(taxon))))
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTaxonomyNormalizationNormalize(t *testing.T) {
+	synonyms := []TaxonSynonyms{
+		{Preferred: "Artificial Intelligence", Synonyms: []TaxonName{"AI", "machine intelligence"}},
+		{Preferred: "FHIR"},
+	}
+	tests := []struct {
+		name          string
+		normalization TaxonomyNormalization
+		taxon         TaxonName
+		want          TaxonName
+	}{
+		{"trimmed", TaxonomyNormalization{}, "  Health IT ", "Health IT"},
+		{"synonym", TaxonomyNormalization{Synonyms: synonyms}, "ai", "Artificial Intelligence"},
+		{"synonym ignores slug differences", TaxonomyNormalization{Synonyms: synonyms}, "Machine-Intelligence", "Artificial Intelligence"},
+		{"preferred keeps its label", TaxonomyNormalization{FoldCase: true, Synonyms: synonyms}, "fhir", "FHIR"},
+		{"fold case", TaxonomyNormalization{FoldCase: true, Synonyms: synonyms}, "Health IT", "health it"},
+		{"slug", TaxonomyNormalization{FoldCase: true, Slug: true}, "Health IT", "health-it"},
+		{"empty", TaxonomyNormalization{Synonyms: synonyms}, "  ", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.normalization.Normalize(test.taxon); got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestContentTaxonomySettingsNormalize(t *testing.T) {
+	cts := ContentTaxonomySettings{
+		TagPathDelimiter: "/",
+		Normalizations: []TaxonomyNormalization{
+			{Taxonomy: "tags", FoldCase: true, Synonyms: []TaxonSynonyms{{Preferred: "Interoperability", Synonyms: []TaxonName{"interop"}}}},
+		}}
+	if got := cts.Normalize("tags", "Interop"); got != "Interoperability" {
+		t.Errorf("got %q", got)
+	}
+	if got := cts.Normalize("other", "Interop"); got != "Interop" {
+		t.Errorf("taxonomy without normalization changed the taxon to %q", got)
+	}
+
+	hierarchy := cts.TagsTaxonomy("tags", []string{"Health IT/interop", "health it/Security"}).(HiearchicalTaxonomy)
+	if got := hierarchy.Paths("/"); !reflect.DeepEqual(got, []string{"health it/Interoperability", "health it/security"}) {
+		t.Errorf("got paths %q", got)
+	}
+
+	cts.TagPathDelimiter = ""
+	flat := cts.TagsTaxonomy("tags", []string{"Interop", "interoperability", "News"}).(FlatTaxonomy)
+	if !reflect.DeepEqual(flat, FlatTaxonomy{Name: "tags", Taxa: []TaxonName{"Interoperability", "news"}}) {
+		t.Errorf("got %+v", flat)
+	}
+
+	// taxa are normalized once, by the settings, so adding to or merging taxonomies doesn't normalize them again
+	flat.Add("Already Normalized")
+	flat.Merge(FlatTaxonomy{Name: "tags", Taxa: []TaxonName{"Interop"}})
+	if !reflect.DeepEqual(flat.Taxa, []TaxonName{"Interoperability", "news", "Already Normalized", "Interop"}) {
+		t.Errorf("got %q", flat.Taxa)
+	}
+}
+
+func TestUnknownTaxa(t *testing.T) {
+	cts := ContentTaxonomySettings{Normalizations: []TaxonomyNormalization{
+		{Taxonomy: "topics", WarnUnknownTaxa: true, Synonyms: []TaxonSynonyms{{Preferred: "FHIR", Synonyms: []TaxonName{"fast healthcare interoperability resources"}}}},
+		{Taxonomy: "quiet", Synonyms: []TaxonSynonyms{{Preferred: "FHIR"}}},
+	}}
+	var hierarchy HiearchicalTaxonomy
+	hierarchy.Name = "topics"
+	hierarchy.AddPath("FHIR", "Profiles")
+
+	tests := []struct {
+		name     string
+		taxonomy Taxonomy
+		want     []TaxonName
+	}{
+		{"flat", FlatTaxonomy{Name: "topics", Taxa: []TaxonName{"fhir", "Blockchain"}}, []TaxonName{"Blockchain"}},
+		{"hierarchical", hierarchy, []TaxonName{"Profiles"}},
+		{"not warned", FlatTaxonomy{Name: "quiet", Taxa: []TaxonName{"Blockchain"}}, nil},
+		{"no normalization", FlatTaxonomy{Name: "other", Taxa: []TaxonName{"Blockchain"}}, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, got := cts.UnknownTaxa(test.taxonomy); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
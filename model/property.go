@@ -92,3 +92,28 @@ func (p Properties) ForEach(do func(key PropertyName, value interface{})) {
 		}
 	}
 }
+
+// Merge adds the properties of other whose names aren't already in p
+func (p *Properties) Merge(other *Properties) {
+	if other == nil {
+		return
+	}
+	for _, item := range other.All {
+		var name PropertyName
+		switch property := item.(type) {
+		case TextProperty:
+			name = property.Name
+		case DateTimeProperty:
+			name = property.Name
+		case FlagProperty:
+			name = property.Name
+		case NumericProperty:
+			name = property.Name
+		default:
+			continue
+		}
+		if _, exists := p.Get(name); !exists {
+			p.All = append(p.All, item)
+		}
+	}
+}
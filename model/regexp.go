@@ -51,3 +51,9 @@ func (t RegularExpression) String() string {
 	re := regexp.Regexp(t)
 	return re.String()
 }
+
+// ReplaceAllString replaces the matches of the regular expression in text, expanding $ references in replacement
+func (t RegularExpression) ReplaceAllString(text, replacement string) string {
+	re := regexp.Regexp(t)
+	return re.ReplaceAllString(text, replacement)
+}
@@ -60,6 +60,7 @@ type Configuration struct {
 	repositoriesStore        map[SettingsStoreName]*Repositories
 	markdownGenStore         map[SettingsStoreName]*MarkdownGeneratorSettings
 	observationSettingsStore map[SettingsStoreName]*ObservationSettings
+	sourceSettingsStore      map[SettingsStoreName]*SourceSettings
 }
 
 // MakeConfiguration creates a new SettingsBundle instance with default options
@@ -79,6 +80,7 @@ func (c *Configuration) init() {
 	c.repositoriesStore = make(map[SettingsStoreName]*Repositories)
 	c.markdownGenStore = make(map[SettingsStoreName]*MarkdownGeneratorSettings)
 	c.observationSettingsStore = make(map[SettingsStoreName]*ObservationSettings)
+	c.sourceSettingsStore = make(map[SettingsStoreName]*SourceSettings)
 }
 
 // HTTPClient returns an HTTP client associated with the given path
@@ -116,6 +118,11 @@ func (c Configuration) ObservationSettings(path SettingsPath) *ObservationSettin
 	return c.observationSettingsStore[SettingsStoreName(path)]
 }
 
+// SourceSettings returns the first SourceSettings found in path, or the default (should never be nil)
+func (c Configuration) SourceSettings(path SettingsPath) *SourceSettings {
+	return c.sourceSettingsStore[SettingsStoreName(path)]
+}
+
 // ContentAddressableStorageHash returns a content addressable hash for the given text
 func (c Configuration) ContentAddressableStorageHash(text string) string {
 	h := sha1.New()
@@ -161,6 +168,17 @@ func (c *Configuration) createDefaults() {
 	linkLC.FollowRedirectsInLinkDestinationHTMLContent = true
 	linkLC.ParseMetaDataInLinkDestinationHTMLContent = true
 	linkLC.DownloadLinkDestinationAttachments = false
+	linkLC.MaxConcurrentHarvests = 16
+	linkLC.MaxConcurrentHarvestsPerHost = 2
+	linkLC.Retry.MaxAttempts = 3
+	linkLC.Retry.InitialBackoff.UnmarshalGQL("1s")
+	linkLC.Retry.MaxBackoff.UnmarshalGQL("30s")
+	linkLC.Retry.RetryStatusCodes = []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+	linkLC.Retry.CircuitBreakerFailures = 5
+	linkLC.Retry.CircuitBreakerCooldown.UnmarshalGQL("5m")
+	linkLC.Robots.HonorRobotsTxt = false
+	linkLC.Robots.CacheDuration.UnmarshalGQL("24h")
+	linkLC.Robots.MaxCrawlDelay.UnmarshalGQL("30s")
 
 	repositories := new(Repositories)
 	repositories.Store = c.defaultStore
@@ -175,6 +193,7 @@ func (c *Configuration) createDefaults() {
 	c.httpClientSettingsStore[httpClientSettings.Store.Name] = httpClientSettings
 	httpClientSettings.UserAgent = "github.com/lectio/graph"
 	httpClientSettings.Timeout.UnmarshalGQL("90s")
+	httpClientSettings.BlockPrivateNetworks = true
 
 	// You can use local HTTP caching but it does not seem to any faster than just running without cache for Link traversal and image downloads.
 	// But, perhaps it might be faster for social scoring? Need to try it out.
@@ -194,8 +213,21 @@ func (c *Configuration) createDefaults() {
 	contentSettings.Title.PipedSuffixPolicy = ContentTitleSuffixPolicyRemove
 	contentSettings.Title.HyphenatedSuffixPolicy = ContentTitleSuffixPolicyWarnIfDetected
 	contentSettings.Summary.Policy = ContentSummaryPolicyUseFirstSentenceOfContentBodyIfEmpty
+	contentSettings.Summary.SentenceSplitter = ContentSentenceSplitterRegEx
+	contentSettings.Summary.ExtractiveSentences = 3
+	contentSettings.Summary.ExtractiveMaxLength = 300
 	contentSettings.Body.AllowFrontmatter = true
 	contentSettings.Body.FrontMatterPropertyNamePrefix = "body."
+	contentSettings.Body.ExtractPolicy = ContentBodyExtractPolicyNever
+	contentSettings.Body.ExtractMinLength = 250
+	contentSettings.Metadata.FillMissingTitle = true
+	contentSettings.Metadata.FillMissingSummary = true
+	contentSettings.Metadata.FillMissingDate = true
+	contentSettings.Metadata.FillMissingFeaturedImage = true
+	contentSettings.Keywords.KeywordsTaxonomy = "keywords"
+	contentSettings.Keywords.EntitiesTaxonomy = "entities"
+	contentSettings.Keywords.MaxTerms = 10
+	contentSettings.Keywords.MinFrequency = 2
 
 	mdgSettings := new(MarkdownGeneratorSettings)
 	mdgSettings.Store = c.defaultStore
@@ -204,11 +236,28 @@ func (c *Configuration) createDefaults() {
 	mdgSettings.ContentPath = "content/post"
 	mdgSettings.ImagesPath = "static/img/content/post"
 	mdgSettings.ImagesURLRel = "/img/content/post"
+	mdgSettings.AttachmentsPath = "static/attachments/content/post"
+	mdgSettings.AttachmentsURLRel = "/attachments/content/post"
+	mdgSettings.SyncStatePath = ".lectio/sync"
+	mdgSettings.DeletedContentPolicy = DeletedContentPolicyIgnore
+	mdgSettings.ArchivePath = ".lectio/archive"
 
 	obsSettings := new(ObservationSettings)
 	obsSettings.Store = c.defaultStore
 	c.observationSettingsStore[mdgSettings.Store.Name] = obsSettings
 	obsSettings.ProgressReporterType = ProgressReporterTypeProgressBar
+
+	sourceSettings := new(SourceSettings)
+	sourceSettings.Store = c.defaultStore
+	c.sourceSettingsStore[sourceSettings.Store.Name] = sourceSettings
+	sourceSettings.OutlinePolicy = SourceOutlinePolicyBookmarkWebsites
+	sourceSettings.LocalFilesRootPath = "."
+	sourceSettings.AllowStdin = false // only command line tools read sources from standard input
+	sourceSettings.Columns.URL = "url"
+	sourceSettings.Columns.Title = "title"
+	sourceSettings.Columns.Tags = "tags"
+	sourceSettings.Columns.TagsDelimiter = ","
+	sourceSettings.Columns.Date = "date"
 }
 
 // Vault returns the default secrets valut
@@ -238,5 +287,8 @@ func (c Configuration) AllSettings() ([]PersistentSettings, error) {
 	for _, v := range c.markdownGenStore {
 		result = append(result, v)
 	}
+	for _, v := range c.sourceSettingsStore {
+		result = append(result, v)
+	}
 	return result, nil
 }
@@ -0,0 +1,122 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// blockedNetworks are the loopback, private, link-local (including cloud metadata services), shared and other
+// special purpose ranges that a server fetching arbitrary URLs should never connect to
+var blockedNetworks = mustParseCIDRs(
+	"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12", "192.0.0.0/24",
+	"192.0.2.0/24", "192.88.99.0/24", "192.168.0.0/16", "198.18.0.0/15", "198.51.100.0/24", "203.0.113.0/24",
+	"224.0.0.0/4", "240.0.0.0/4", "255.255.255.255/32",
+	"::/128", "::1/128", "64:ff9b::/96", "100::/64", "2001:db8::/32", "fc00::/7", "fe80::/10", "ff00::/8",
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	result := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		result = append(result, network)
+	}
+	return result
+}
+
+// addressGuard is a dialer which refuses to connect to blocked networks unless they're allowed
+type addressGuard struct {
+	dialer          *net.Dialer
+	allowedNetworks []*net.IPNet
+	allowedHosts    map[string]bool
+}
+
+// newAddressGuard creates a guard allowing the HTTPClientSettings AllowedNetworks, each of which is a CIDR, an IP
+// address or a host name; invalid entries are reported but the guard is always valid
+func (hcs HTTPClientSettings) newAddressGuard() (*addressGuard, error) {
+	result := new(addressGuard)
+	result.allowedHosts = make(map[string]bool)
+	var invalid []string
+	for _, allowed := range hcs.AllowedNetworks {
+		allowed = strings.TrimSpace(allowed)
+		if _, network, err := net.ParseCIDR(allowed); err == nil {
+			result.allowedNetworks = append(result.allowedNetworks, network)
+		} else if ip := net.ParseIP(allowed); ip != nil {
+			result.allowedNetworks = append(result.allowedNetworks, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+		} else if len(allowed) > 0 && !strings.ContainsAny(allowed, "/ ") {
+			result.allowedHosts[strings.ToLower(strings.TrimSuffix(allowed, "."))] = true
+		} else {
+			invalid = append(invalid, allowed)
+		}
+	}
+	result.dialer = &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, Control: result.control}
+
+	if len(invalid) > 0 {
+		return result, fmt.Errorf("Invalid HTTPClientSettings allowedNetworks %q, expected CIDRs, IP addresses or host names", invalid)
+	}
+	return result, nil
+}
+
+// DialContext dials the address unless, after DNS resolution, it's in a blocked network; because every connection
+// is dialed here, the addresses that redirects lead to are checked too
+func (g *addressGuard) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err == nil && g.allowedHosts[strings.ToLower(strings.TrimSuffix(host, "."))] {
+		return (&net.Dialer{Timeout: g.dialer.Timeout, KeepAlive: g.dialer.KeepAlive}).DialContext(ctx, network, address)
+	}
+	return g.dialer.DialContext(ctx, network, address)
+}
+
+// control is called with the resolved IP address just before each connection is made
+func (g *addressGuard) control(network, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("unable to verify address %q", address)
+	}
+	if !g.allowed(ip) {
+		return fmt.Errorf("connecting to %s is not allowed because it's in a private or reserved network", ip)
+	}
+	return nil
+}
+
+func (g *addressGuard) allowed(ip net.IP) bool {
+	for _, network := range g.allowedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// newTransport returns the transport for HTTP clients, guarded against connecting to private networks when
+// BlockPrivateNetworks is set or nil to use the default transport
+func (hcs HTTPClientSettings) newTransport() (http.RoundTripper, error) {
+	if !hcs.BlockPrivateNetworks {
+		return nil, nil
+	}
+	guard, err := hcs.newAddressGuard()
+	return &http.Transport{
+		// proxies are not used because the guard can only check the addresses it connects to
+		DialContext:           guard.DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}, err
+}
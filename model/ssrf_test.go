@@ -0,0 +1,130 @@
+package model
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAddressGuardAllowed(t *testing.T) {
+	tests := []struct {
+		ip      string
+		allowed []string
+		want    bool
+	}{
+		{"93.184.216.34", nil, true},
+		{"2606:2800:220:1:248:1893:25c8:1946", nil, true},
+		{"127.0.0.1", nil, false},
+		{"10.1.2.3", nil, false},
+		{"172.16.0.1", nil, false},
+		{"192.168.1.1", nil, false},
+		{"169.254.169.254", nil, false},
+		{"100.64.0.1", nil, false},
+		{"0.0.0.0", nil, false},
+		{"::1", nil, false},
+		{"fd00::1", nil, false},
+		{"fe80::1", nil, false},
+		{"::ffff:127.0.0.1", nil, false},
+		{"10.1.2.3", []string{"10.0.0.0/8"}, true},
+		{"10.1.2.3", []string{"10.1.2.3"}, true},
+		{"10.1.2.4", []string{"10.1.2.3"}, false},
+		{"192.168.1.1", []string{"10.0.0.0/8", " 192.168.1.0/24 "}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.ip+" "+strings.Join(test.allowed, ","), func(t *testing.T) {
+			guard, err := HTTPClientSettings{AllowedNetworks: test.allowed}.newAddressGuard()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := guard.allowed(net.ParseIP(test.ip)); got != test.want {
+				t.Errorf("allowed %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNewAddressGuardAllowedNetworks(t *testing.T) {
+	tests := []struct {
+		name      string
+		allowed   []string
+		networks  int
+		hosts     []string
+		wantError bool
+	}{
+		{"CIDRs and IPs", []string{"10.0.0.0/8", "fd00::/8", "127.0.0.1"}, 3, nil, false},
+		{"host names", []string{"intranet.example.com.", "LOCALHOST"}, 0, []string{"intranet.example.com", "localhost"}, false},
+		{"invalid entries", []string{"10.0.0.0/99", "not a host", "10.0.0.0/8"}, 1, nil, true},
+		{"empty entries", []string{""}, 0, nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			guard, err := HTTPClientSettings{AllowedNetworks: test.allowed}.newAddressGuard()
+			if (err != nil) != test.wantError {
+				t.Errorf("got error %v, want error %v", err, test.wantError)
+			}
+			if guard == nil {
+				t.Fatal("no guard")
+			}
+			if len(guard.allowedNetworks) != test.networks || len(guard.allowedHosts) != len(test.hosts) {
+				t.Errorf("got networks %v and hosts %v", guard.allowedNetworks, guard.allowedHosts)
+			}
+			for _, host := range test.hosts {
+				if !guard.allowedHosts[host] {
+					t.Errorf("host %q isn't allowed", host)
+				}
+			}
+		})
+	}
+}
+
+func TestBlockPrivateNetworksTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect" {
+			// the redirect leads to the loopback address by IP rather than by the allowed host name
+			http.Redirect(w, r, "http://127.0.0.1"+r.Host[strings.LastIndex(r.Host, ":"):]+"/page", http.StatusFound)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+	port := server.URL[strings.LastIndex(server.URL, ":")+1:]
+
+	tests := []struct {
+		name    string
+		block   bool
+		allowed []string
+		urlText string
+		wantOK  bool
+	}{
+		{"not blocked", false, nil, "http://127.0.0.1:" + port + "/page", true},
+		{"loopback blocked", true, nil, "http://127.0.0.1:" + port + "/page", false},
+		{"localhost blocked after resolution", true, nil, "http://localhost:" + port + "/page", false},
+		{"allowed network", true, []string{"127.0.0.0/8"}, "http://127.0.0.1:" + port + "/page", true},
+		{"allowed host name", true, []string{"localhost"}, "http://localhost:" + port + "/page", true},
+		{"redirect to blocked address", true, []string{"localhost"}, "http://localhost:" + port + "/redirect", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			transport, err := HTTPClientSettings{BlockPrivateNetworks: test.block, AllowedNetworks: test.allowed}.newTransport()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (transport == nil) == test.block {
+				t.Fatalf("got transport %v, want guarded transport %v", transport, test.block)
+			}
+			client := &http.Client{Transport: transport}
+			resp, err := client.Get(test.urlText)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if (err == nil) != test.wantOK {
+				t.Errorf("got error %v, want success %v", err, test.wantOK)
+			}
+			if err != nil && test.block && !strings.Contains(err.Error(), "not allowed") {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package model
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/jdkato/prose.v2"
+)
+
+var sentenceRegExp = regexp.MustCompile(`(?s).*?[.?!]+(\s+|$)`)                           // Matches each sentence, ending with its punctuation
+var markdownSyntaxRegExp = regexp.MustCompile("(?m)^\\s*(#+|>|[-*+]|\\d+\\.)\\s+|[*_`]+") // Matches headings, quotes, list markers and emphasis
+var summaryWordRegExp = regexp.MustCompile(`[\pL\pN']+`)
+
+const (
+	textRankDamping    = 0.85
+	textRankIterations = 50
+	textRankTolerance  = 0.0001
+)
+
+// Sentences splits the body, with its markdown syntax removed, into sentences using the splitter
+func (t ContentBodyText) Sentences(splitter ContentSentenceSplitter) ([]string, error) {
+	text := markdownLinkRegExp.ReplaceAllString(string(t), "$1")
+	text = markdownSyntaxRegExp.ReplaceAllString(text, "")
+
+	var sentences []string
+	if splitter == ContentSentenceSplitterNlp {
+		doc, proseErr := prose.NewDocument(text, prose.WithTagging(false), prose.WithExtraction(false))
+		if proseErr != nil {
+			return nil, proseErr
+		}
+		for _, sentence := range doc.Sentences() {
+			sentences = append(sentences, sentence.Text)
+		}
+	} else {
+		// paragraphs are split first because headings and list items often don't end with punctuation
+		for _, paragraph := range strings.Split(text, "\n\n") {
+			matched := 0
+			for _, sentence := range sentenceRegExp.FindAllString(paragraph, -1) {
+				sentences = append(sentences, sentence)
+				matched += len(sentence)
+			}
+			sentences = append(sentences, paragraph[matched:])
+		}
+	}
+
+	result := make([]string, 0, len(sentences))
+	for _, sentence := range sentences {
+		if sentence = strings.Join(strings.Fields(sentence), " "); len(sentence) > 0 {
+			result = append(result, sentence)
+		}
+	}
+	return result, nil
+}
+
+// ExtractiveSummary uses TextRank to pick the body's most central sentences and returns them in the order they
+// appear, limited to ExtractiveSentences sentences and ExtractiveMaxLength characters when those are positive
+func (t ContentBodyText) ExtractiveSummary(settings *ContentSummarySettings) (string, error) {
+	sentences, err := t.Sentences(settings.SentenceSplitter)
+	if err != nil || len(sentences) == 0 {
+		return "", err
+	}
+
+	scores := textRank(sentences)
+	ranked := make([]int, len(sentences))
+	for i := range ranked {
+		ranked[i] = i
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[i]] > scores[ranked[j]]
+	})
+
+	var chosen []int
+	length := 0
+	for _, index := range ranked {
+		if settings.ExtractiveSentences > 0 && len(chosen) >= settings.ExtractiveSentences {
+			break
+		}
+		sentenceLength := len(sentences[index])
+		if len(chosen) > 0 {
+			sentenceLength++
+		}
+		if settings.ExtractiveMaxLength > 0 && length+sentenceLength > settings.ExtractiveMaxLength {
+			continue
+		}
+		chosen = append(chosen, index)
+		length += sentenceLength
+	}
+	if len(chosen) == 0 {
+		// even the most central sentence is longer than the budget
+		return truncateText(sentences[ranked[0]], settings.ExtractiveMaxLength), nil
+	}
+
+	sort.Ints(chosen)
+	summary := make([]string, len(chosen))
+	for i, index := range chosen {
+		summary[i] = sentences[index]
+	}
+	return strings.Join(summary, " "), nil
+}
+
+// textRank scores each sentence by how much its words overlap with the other sentences
+func textRank(sentences []string) []float64 {
+	words := make([]map[string]bool, len(sentences))
+	for i, sentence := range sentences {
+		words[i] = make(map[string]bool)
+		for _, word := range summaryWordRegExp.FindAllString(strings.ToLower(sentence), -1) {
+			if !defaultStopWords[word] {
+				words[i][word] = true
+			}
+		}
+	}
+
+	count := len(sentences)
+	weights := make([][]float64, count)
+	totals := make([]float64, count)
+	for i := range weights {
+		weights[i] = make([]float64, count)
+	}
+	for i := 0; i < count; i++ {
+		for j := i + 1; j < count; j++ {
+			overlap := 0
+			for word := range words[i] {
+				if words[j][word] {
+					overlap++
+				}
+			}
+			if overlap == 0 {
+				continue
+			}
+			weight := float64(overlap) / (math.Log(float64(len(words[i])+1)) + math.Log(float64(len(words[j])+1)))
+			weights[i][j], weights[j][i] = weight, weight
+			totals[i] += weight
+			totals[j] += weight
+		}
+	}
+
+	scores := make([]float64, count)
+	for i := range scores {
+		scores[i] = 1
+	}
+	for iteration := 0; iteration < textRankIterations; iteration++ {
+		next := make([]float64, count)
+		change := 0.0
+		for i := 0; i < count; i++ {
+			sum := 0.0
+			for j := 0; j < count; j++ {
+				if weights[j][i] > 0 {
+					sum += weights[j][i] / totals[j] * scores[j]
+				}
+			}
+			next[i] = (1 - textRankDamping) + textRankDamping*sum
+			change += math.Abs(next[i] - scores[i])
+		}
+		scores = next
+		if change < textRankTolerance {
+			break
+		}
+	}
+	return scores
+}
+
+// truncationEllipsis ends truncated text
+const truncationEllipsis = "…"
+
+// truncateText shortens text to at most max bytes, including the ellipsis it ends with, at a word boundary when
+// there is one; the ellipsis is left out when max has no room for it
+func truncateText(text string, max int) string {
+	if max <= 0 || len(text) <= max {
+		return text
+	}
+	ellipsis := truncationEllipsis
+	limit := max - len(ellipsis)
+	if limit <= 0 {
+		ellipsis = ""
+		limit = max
+	}
+	// a space right after the limit is a word boundary too
+	cut := strings.LastIndex(text[:limit+1], " ")
+	if cut <= 0 {
+		for cut = limit; cut > 0 && !utf8.RuneStart(text[cut]); cut-- {
+		}
+	}
+	return strings.TrimRight(text[:cut], " ,;:") + ellipsis
+}
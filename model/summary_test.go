@@ -0,0 +1,145 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testSummaryBody = "FHIR servers exchange patient records. Patient records need consent. FHIR servers need security. Cats sleep."
+
+func TestTruncateText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		max  int
+		want string
+	}{
+		{"short enough", "Short text", 20, "Short text"},
+		{"exactly max", "Exactly twelve", 14, "Exactly twelve"},
+		{"no limit", "Not truncated at all", 0, "Not truncated at all"},
+		{"word boundary", "The quick brown fox jumps", 15, "The quick…"},
+		{"space right after the limit", "The quick brown fox", 12, "The quick…"},
+		{"trailing punctuation", "Health, wellness and care", 12, "Health…"},
+		{"single long word", "Supercalifragilistic", 10, "Superca…"},
+		{"rune boundary", "éééééé", 8, "éé…"},
+		{"no room for the ellipsis", "abcdef", 3, "abc"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := truncateText(test.text, test.max)
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+			if test.max > 0 && len(got) > test.max {
+				t.Errorf("got %d bytes, more than %d", len(got), test.max)
+			}
+		})
+	}
+}
+
+func TestSentences(t *testing.T) {
+	tests := []struct {
+		name string
+		body ContentBodyText
+		want []string
+	}{
+		{"sentences", "First sentence. Second one? Third!", []string{"First sentence.", "Second one?", "Third!"}},
+		{"paragraphs without punctuation", "# Heading\n\nFirst sentence. Second one?\n\n- item one\n- item two",
+			[]string{"Heading", "First sentence.", "Second one?", "item one item two"}},
+		{"markdown removed", "Read **the** [FHIR spec](https://hl7.org/fhir) and `code`.", []string{"Read the FHIR spec and code."}},
+		{"whitespace collapsed", "  Spread\n  over   lines.  ", []string{"Spread over lines."}},
+		{"empty", "", []string{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.body.Sentences(ContentSentenceSplitterRegEx)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestTextRank(t *testing.T) {
+	sentences, _ := ContentBodyText(testSummaryBody).Sentences(ContentSentenceSplitterRegEx)
+	scores := textRank(sentences)
+	if len(scores) != len(sentences) {
+		t.Fatalf("got %d scores for %d sentences", len(scores), len(sentences))
+	}
+	for i := 1; i < len(scores); i++ {
+		if scores[0] <= scores[i] {
+			t.Errorf("central sentence scored %f, not more than %q at %f", scores[0], sentences[i], scores[i])
+		}
+	}
+	if unconnected := 1 - textRankDamping; scores[3] < unconnected-textRankTolerance || scores[3] > unconnected+textRankTolerance {
+		t.Errorf("sentence sharing no words scored %f, want %f", scores[3], unconnected)
+	}
+	if got := textRank(nil); len(got) != 0 {
+		t.Errorf("got %v scores without sentences", got)
+	}
+}
+
+func TestExtractiveSummary(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      ContentBodyText
+		sentences int
+		maxLength int
+		want      string
+	}{
+		{"most central sentence", testSummaryBody, 1, 0, "FHIR servers exchange patient records."},
+		{"unlimited keeps the order", testSummaryBody, 0, 0, testSummaryBody},
+		{"sentences longer than the budget skipped", testSummaryBody, 0, 50, "FHIR servers exchange patient records. Cats sleep."},
+		{"most central sentence truncated", testSummaryBody, 0, 10, "FHIR…"},
+		{"empty body", "", 1, 0, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			settings := &ContentSummarySettings{SentenceSplitter: ContentSentenceSplitterRegEx, ExtractiveSentences: test.sentences, ExtractiveMaxLength: test.maxLength}
+			got, err := test.body.ExtractiveSummary(settings)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+			if test.maxLength > 0 && len(got) > test.maxLength {
+				t.Errorf("got %d bytes, more than %d", len(got), test.maxLength)
+			}
+		})
+	}
+}
+
+func TestContentSummaryTextEdit(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  ContentSummaryPolicy
+		summary ContentSummaryText
+		body    string
+		want    ContentSummaryText
+	}{
+		{"first sentence", ContentSummaryPolicyAlwaysUseFirstSentenceOfContentBody, "Existing.", "First one. Second.", "First one."},
+		{"first sentence if empty keeps the summary", ContentSummaryPolicyUseFirstSentenceOfContentBodyIfEmpty, "Existing.", "First one. Second.", "Existing."},
+		{"first sentence if empty", ContentSummaryPolicyUseFirstSentenceOfContentBodyIfEmpty, "", "First one. Second.", "First one."},
+		{"extractive summary", ContentSummaryPolicyAlwaysUseExtractiveSummaryOfContentBody, "Existing.", testSummaryBody, "FHIR servers exchange patient records."},
+		{"extractive summary if empty keeps the summary", ContentSummaryPolicyUseExtractiveSummaryOfContentBodyIfEmpty, "Existing.", testSummaryBody, "Existing."},
+		// the meta description fallback is applied when the destination is harvested, not by editing
+		{"no meta description fallback", ContentSummaryPolicyUseFirstSentenceOfContentBodyIfEmpty, "", "", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bookmark := testBookmark(t, "", "", test.body, "og.description", "The page's description.")
+			bookmark.Summary = test.summary
+			settings := &ContentSummarySettings{Policy: test.policy, SentenceSplitter: ContentSentenceSplitterRegEx, ExtractiveSentences: 1, FallbackToMetaDescription: true}
+			if err := bookmark.Summary.Edit(bookmark, settings); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if bookmark.Summary != test.want {
+				t.Errorf("got %q, want %q", bookmark.Summary, test.want)
+			}
+		})
+	}
+}
@@ -1,8 +1,196 @@
 package model
 
+import (
+	"strings"
+)
+
+// defaultTaxonPathDelimiter separates the taxa of a path when no tag path delimiter is configured
+const defaultTaxonPathDelimiter = "/"
+
 type TaxonomyName string
 type TaxonName string
 
+// Add appends the taxon unless it's empty or already in the taxonomy; taxa are normalized by the
+// ContentTaxonomySettings before they're added
 func (t *FlatTaxonomy) Add(name TaxonName) {
+	if len(name) == 0 {
+		return
+	}
+	for _, existing := range t.Taxa {
+		if existing == name {
+			return
+		}
+	}
 	t.Taxa = append(t.Taxa, name)
 }
+
+// AddPath adds the taxa, from the root down, as a path of nested TaxonNode items reusing existing nodes
+func (t *HiearchicalTaxonomy) AddPath(path ...TaxonName) {
+	nodes := &t.Taxa
+	for _, name := range path {
+		index := -1
+		for i, node := range *nodes {
+			if node.Taxon != nil && *node.Taxon == name {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			taxon := name
+			*nodes = append(*nodes, TaxonNode{Taxon: &taxon})
+			index = len(*nodes) - 1
+		}
+		nodes = &(*nodes)[index].Taxa
+	}
+}
+
+// Leaves returns the taxa at the end of each path, in the order they appear in the hierarchy
+func (t HiearchicalTaxonomy) Leaves() []TaxonName {
+	var result []TaxonName
+	t.walkPaths(func(path []TaxonName) {
+		result = append(result, path[len(path)-1])
+	})
+	return result
+}
+
+// Paths returns each path from the root to a leaf with its taxa joined by delimiter
+func (t HiearchicalTaxonomy) Paths(delimiter string) []string {
+	var result []string
+	t.walkPaths(func(path []TaxonName) {
+		names := make([]string, len(path))
+		for i, name := range path {
+			names[i] = string(name)
+		}
+		result = append(result, strings.Join(names, delimiter))
+	})
+	return result
+}
+
+func (t HiearchicalTaxonomy) walkPaths(fn func(path []TaxonName)) {
+	var walk func(nodes []TaxonNode, path []TaxonName)
+	walk = func(nodes []TaxonNode, path []TaxonName) {
+		for _, node := range nodes {
+			if node.Taxon == nil {
+				continue
+			}
+			nodePath := append(path[:len(path):len(path)], *node.Taxon)
+			if len(node.Taxa) == 0 {
+				fn(nodePath)
+			} else {
+				walk(node.Taxa, nodePath)
+			}
+		}
+	}
+	walk(t.Taxa, nil)
+}
+
+// PathDelimiter returns the delimiter which separates the taxa of tag paths, the default is used for writing paths
+// when tags are not split
+func (cts ContentTaxonomySettings) PathDelimiter() string {
+	if len(cts.TagPathDelimiter) > 0 {
+		return cts.TagPathDelimiter
+	}
+	return defaultTaxonPathDelimiter
+}
+
+// TagsTaxonomy creates the taxonomy called name for a source's tags, or nil if there are none; when a
+// TagPathDelimiter is configured each tag is split into a path of a HiearchicalTaxonomy
+func (cts ContentTaxonomySettings) TagsTaxonomy(name TaxonomyName, tags []string) Taxonomy {
+	if len(tags) == 0 {
+		return nil
+	}
+	if len(cts.TagPathDelimiter) == 0 {
+		flat := FlatTaxonomy{Name: name}
+		for _, tag := range tags {
+			flat.Add(cts.Normalize(name, TaxonName(tag)))
+		}
+		return flat
+	}
+	hierarchy := HiearchicalTaxonomy{Name: name}
+	for _, tag := range tags {
+		hierarchy.AddPath(cts.TaxonPath(name, tag)...)
+	}
+	return hierarchy
+}
+
+// TaxonPath splits text such as "health/it/interop" by the PathDelimiter into the taxa of the taxonomy called
+// name, normalized and skipping empty ones
+func (cts ContentTaxonomySettings) TaxonPath(name TaxonomyName, text string) []TaxonName {
+	var result []TaxonName
+	for _, taxon := range SplitTaxonPath(text, cts.PathDelimiter()) {
+		if taxon = cts.Normalize(name, taxon); len(taxon) > 0 {
+			result = append(result, taxon)
+		}
+	}
+	return result
+}
+
+// SplitTaxonPath splits text such as "health/it/interop" into its taxa, skipping empty ones
+func SplitTaxonPath(text, delimiter string) []TaxonName {
+	var result []TaxonName
+	for _, part := range strings.Split(text, delimiter) {
+		if part = strings.TrimSpace(part); len(part) > 0 {
+			result = append(result, TaxonName(part))
+		}
+	}
+	return result
+}
+
+// Merge adds the taxa of other that aren't already in the taxonomy
+func (t *FlatTaxonomy) Merge(other FlatTaxonomy) {
+	for _, taxon := range other.Taxa {
+		t.Add(taxon)
+	}
+}
+
+// Merge adds the taxon nodes of other, reusing the nodes that already exist at the same place in the hierarchy
+func (t *HiearchicalTaxonomy) Merge(other HiearchicalTaxonomy) {
+	mergeTaxonNodes(&t.Taxa, other.Taxa)
+}
+
+func mergeTaxonNodes(nodes *[]TaxonNode, others []TaxonNode) {
+	for _, other := range others {
+		index := -1
+		for i, node := range *nodes {
+			if node.Taxon != nil && other.Taxon != nil && *node.Taxon == *other.Taxon {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			*nodes = append(*nodes, TaxonNode{Taxon: other.Taxon})
+			index = len(*nodes) - 1
+		}
+		mergeTaxonNodes(&(*nodes)[index].Taxa, other.Taxa)
+	}
+}
+
+// MergeTaxonomies returns the union of two lists of taxonomies, taxonomies with the same name and type are merged
+func MergeTaxonomies(taxonomies []Taxonomy, others []Taxonomy) []Taxonomy {
+	for _, other := range others {
+		merged := false
+		for index, taxonomy := range taxonomies {
+			switch existing := taxonomy.(type) {
+			case FlatTaxonomy:
+				if flat, ok := other.(FlatTaxonomy); ok && flat.Name == existing.Name {
+					existing.Merge(flat)
+					taxonomies[index] = existing
+					merged = true
+				}
+			case HiearchicalTaxonomy:
+				if hierarchy, ok := other.(HiearchicalTaxonomy); ok && hierarchy.Name == existing.Name {
+					existing.Merge(hierarchy)
+					taxonomies[index] = existing
+					merged = true
+				}
+			}
+			if merged {
+				break
+			}
+		}
+		if !merged {
+			taxonomies = append(taxonomies, other)
+		}
+	}
+	return taxonomies
+}
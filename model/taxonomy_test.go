@@ -0,0 +1,133 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitTaxonPath(t *testing.T) {
+	tests := []struct {
+		text      string
+		delimiter string
+		want      []TaxonName
+	}{
+		{"health/it/interop", "/", []TaxonName{"health", "it", "interop"}},
+		{" Health IT / Interoperability ", "/", []TaxonName{"Health IT", "Interoperability"}},
+		{"/health//it/", "/", []TaxonName{"health", "it"}},
+		{"health::it", "::", []TaxonName{"health", "it"}},
+		{"health", "/", []TaxonName{"health"}},
+		{" / ", "/", nil},
+	}
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			if got := SplitTaxonPath(test.text, test.delimiter); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestTagsTaxonomy(t *testing.T) {
+	tests := []struct {
+		name       string
+		delimiter  string
+		tags       []string
+		wantNil    bool
+		wantPaths  []string
+		wantLeaves []TaxonName
+		wantFlat   []TaxonName
+	}{
+		{"no tags", "/", nil, true, nil, nil, nil},
+		{"flat without delimiter", "", []string{"health/it", "news", "news"}, false, nil, nil, []TaxonName{"health/it", "news"}},
+		{"paths share nodes", "/", []string{"health/it/interop", "health/it/security", "news"}, false,
+			[]string{"health/it/interop", "health/it/security", "news"}, []TaxonName{"interop", "security", "news"}, nil},
+		{"parent tag after child", "/", []string{"health/it", "health"}, false,
+			[]string{"health/it"}, []TaxonName{"it"}, nil},
+		{"empty path parts", "/", []string{"/health//it/", " / "}, false,
+			[]string{"health/it"}, []TaxonName{"it"}, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cts := ContentTaxonomySettings{TagPathDelimiter: test.delimiter}
+			taxonomy := cts.TagsTaxonomy("tags", test.tags)
+			if test.wantNil {
+				if taxonomy != nil {
+					t.Errorf("got %+v, want nil", taxonomy)
+				}
+				return
+			}
+			switch result := taxonomy.(type) {
+			case FlatTaxonomy:
+				if result.Name != "tags" || !reflect.DeepEqual(result.Taxa, test.wantFlat) || test.wantFlat == nil {
+					t.Errorf("got flat taxonomy %+v, want %q", result, test.wantFlat)
+				}
+			case HiearchicalTaxonomy:
+				if result.Name != "tags" || test.wantPaths == nil {
+					t.Fatalf("got hierarchical taxonomy %+v", result)
+				}
+				if got := result.Paths("/"); !reflect.DeepEqual(got, test.wantPaths) {
+					t.Errorf("got paths %q, want %q", got, test.wantPaths)
+				}
+				if got := result.Leaves(); !reflect.DeepEqual(got, test.wantLeaves) {
+					t.Errorf("got leaves %q, want %q", got, test.wantLeaves)
+				}
+			default:
+				t.Errorf("unexpected taxonomy %+v", taxonomy)
+			}
+		})
+	}
+}
+
+func TestHiearchicalTaxonomyMerge(t *testing.T) {
+	tests := []struct {
+		name  string
+		paths [][]TaxonName
+		other [][]TaxonName
+		want  []string
+	}{
+		{"disjoint", [][]TaxonName{{"a", "b"}}, [][]TaxonName{{"c"}}, []string{"a > b", "c"}},
+		{"shared root", [][]TaxonName{{"a", "b"}}, [][]TaxonName{{"a", "c"}}, []string{"a > b", "a > c"}},
+		{"same path", [][]TaxonName{{"a", "b"}}, [][]TaxonName{{"a", "b"}}, []string{"a > b"}},
+		{"into empty", nil, [][]TaxonName{{"a", "b"}, {"a", "c", "d"}}, []string{"a > b", "a > c > d"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var taxonomy, other HiearchicalTaxonomy
+			for _, path := range test.paths {
+				taxonomy.AddPath(path...)
+			}
+			for _, path := range test.other {
+				other.AddPath(path...)
+			}
+			taxonomy.Merge(other)
+			if got := taxonomy.Paths(" > "); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMergeTaxonomies(t *testing.T) {
+	var hierarchy HiearchicalTaxonomy
+	hierarchy.Name = "tags"
+	hierarchy.AddPath("health", "it")
+	var otherHierarchy HiearchicalTaxonomy
+	otherHierarchy.Name = "tags"
+	otherHierarchy.AddPath("health", "policy")
+
+	merged := MergeTaxonomies(
+		[]Taxonomy{FlatTaxonomy{Name: "topics", Taxa: []TaxonName{"a"}}, hierarchy},
+		[]Taxonomy{FlatTaxonomy{Name: "topics", Taxa: []TaxonName{"b", "a"}}, otherHierarchy, FlatTaxonomy{Name: "tags", Taxa: []TaxonName{"flat"}}})
+	if len(merged) != 3 {
+		t.Fatalf("got %d taxonomies, want 3: %+v", len(merged), merged)
+	}
+	if topics := merged[0].(FlatTaxonomy); !reflect.DeepEqual(topics.Taxa, []TaxonName{"a", "b"}) {
+		t.Errorf("got topics %q", topics.Taxa)
+	}
+	if tags := merged[1].(HiearchicalTaxonomy); !reflect.DeepEqual(tags.Paths("/"), []string{"health/it", "health/policy"}) {
+		t.Errorf("got tags %q", tags.Paths("/"))
+	}
+	if flat, ok := merged[2].(FlatTaxonomy); !ok || flat.Name != "tags" {
+		t.Errorf("a flat and a hierarchical taxonomy with the same name were merged: %+v", merged[2])
+	}
+}
@@ -0,0 +1,142 @@
+package pipeline
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/lectio/graph/model"
+	"github.com/lectio/markdown"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v2"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// propagateDeletions finds previously generated files from this pipeline's source whose original link is no
+// longer in the source (because it was deleted or removed) and applies the DeletedContentPolicy to them; it only
+// runs for sources which reported all of their links, for the others a missing link doesn't mean it was deleted
+func (p *BookmarksToMarkdown) propagateDeletions(bookmarks *model.Bookmarks) {
+	policy := p.markdownSettings.DeletedContentPolicy
+	if policy == model.DeletedContentPolicyIgnore || !bookmarks.SourceLinksComplete {
+		return
+	}
+
+	current := make(map[model.URLText]bool, len(bookmarks.SourceLinks))
+	for _, link := range bookmarks.SourceLinks {
+		current[link] = true
+	}
+
+	var stale []string
+	walkErr := afero.Walk(p.contentFS, "/", func(fileName string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.EqualFold(filepath.Ext(fileName), ".md") {
+			return nil
+		}
+		frontmatter, _, readErr := p.readGenerated(fileName)
+		if readErr != nil {
+			p.exec.Activities.AddWarning(fileName, "BM2MDERR_STALE_READ", readErr.Error())
+			return nil
+		}
+		if !p.generatedFromSource(frontmatter) {
+			return nil
+		}
+		originalLink, ok := frontmatter["originalLink"].(string)
+		if ok && !current[model.URLText(originalLink)] {
+			stale = append(stale, fileName)
+		}
+		return nil
+	})
+	if walkErr != nil {
+		p.exec.Activities.AddError(p.pipelineURL.String(), "BM2MDERR_STALE_WALK", fmt.Sprintf("Unable to find stale content: %v", walkErr.Error()))
+		return
+	}
+
+	for _, fileName := range stale {
+		context := fmt.Sprintf("[%q] stale %q", p.pipelineURL.String(), fileName)
+		switch policy {
+		case model.DeletedContentPolicyDelete:
+			if err := p.contentFS.Remove(fileName); err != nil {
+				p.exec.Activities.AddError(context, "BM2MDERR_STALE_DELETE", fmt.Sprintf("Unable to delete: %v", err.Error()))
+				continue
+			}
+		case model.DeletedContentPolicyArchive:
+			if err := p.archive(fileName); err != nil {
+				p.exec.Activities.AddError(context, "BM2MDERR_STALE_ARCHIVE", fmt.Sprintf("Unable to archive: %v", err.Error()))
+				continue
+			}
+		case model.DeletedContentPolicyMarkDraft:
+			changed, err := p.markDraft(fileName)
+			if err != nil {
+				p.exec.Activities.AddError(context, "BM2MDERR_STALE_DRAFT", fmt.Sprintf("Unable to mark as draft: %v", err.Error()))
+				continue
+			}
+			if !changed {
+				continue
+			}
+		}
+		p.exec.Activities.AddHistory(&model.ActivityLog{
+			Context: model.ActivityContext(context),
+			Code:    model.ActivityCode("BM2MD_STALE_" + strings.ToUpper(policy.String())),
+			Message: model.ActivityHumanMessage(fmt.Sprintf("Source item no longer available, applied %s policy to %q", policy, fileName))})
+	}
+}
+
+// readGenerated returns the front matter and body of a previously generated markdown file
+func (p BookmarksToMarkdown) readGenerated(fileName string) (map[string]interface{}, []byte, error) {
+	content, err := afero.ReadFile(p.contentFS, fileName)
+	if err != nil {
+		return nil, nil, err
+	}
+	frontmatter := make(map[string]interface{})
+	body, haveFrontMatter, err := markdown.ParseYAMLFrontMatter(content, frontmatter)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !haveFrontMatter {
+		return nil, nil, fmt.Errorf("no front matter found in %q", fileName)
+	}
+	return frontmatter, body, nil
+}
+
+// generatedFromSource returns true if the front matter was written by this pipeline for the same source
+func (p BookmarksToMarkdown) generatedFromSource(frontmatter map[string]interface{}) bool {
+	source, ok := frontmatter["source"].(map[interface{}]interface{})
+	if !ok {
+		return false
+	}
+	apiEndpoint, ok := source["apiendpoint"].(string)
+	return ok && model.URLText(apiEndpoint) == p.linksAPISource.(*model.BookmarksAPISource).APIEndpoint
+}
+
+// archive moves a generated file from the content path to the archive path
+func (p BookmarksToMarkdown) archive(fileName string) error {
+	archiveName := filepath.Join(p.markdownSettings.ArchivePath, fileName)
+	if err := p.baseFS.MkdirAll(filepath.Dir(archiveName), p.repoMan.DirPerm()); err != nil {
+		return err
+	}
+	return p.baseFS.Rename(filepath.Join(p.markdownSettings.ContentPath, fileName), archiveName)
+}
+
+// markDraft sets draft: true in a generated file's front matter, returning false if it was already a draft
+func (p BookmarksToMarkdown) markDraft(fileName string) (bool, error) {
+	frontmatter, body, err := p.readGenerated(fileName)
+	if err != nil {
+		return false, err
+	}
+	if draft, ok := frontmatter["draft"].(bool); ok && draft {
+		return false, nil
+	}
+	frontmatter["draft"] = true
+
+	fmBytes, err := yaml.Marshal(frontmatter)
+	if err != nil {
+		return false, err
+	}
+	content := bytes.NewBufferString("---\n")
+	content.Write(fmBytes)
+	content.WriteString("---\n")
+	content.Write(body)
+	return true, afero.WriteFile(p.contentFS, fileName, content.Bytes(), p.fileWriteMode)
+}
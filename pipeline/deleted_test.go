@@ -0,0 +1,67 @@
+package pipeline
+
+import (
+	"github.com/lectio/graph/model"
+	"github.com/spf13/afero"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPropagateDeletions(t *testing.T) {
+	kept := testItem{link: "https://example.com/kept", title: "Kept"}
+	removed := testItem{link: "https://example.com/removed", title: "Removed"}
+
+	tests := []struct {
+		name         string
+		policy       model.DeletedContentPolicy
+		complete     bool
+		wantContent  bool
+		wantDraft    bool
+		wantArchived bool
+	}{
+		{"ignore", model.DeletedContentPolicyIgnore, true, true, false, false},
+		{"delete", model.DeletedContentPolicyDelete, true, false, false, false},
+		{"archive", model.DeletedContentPolicyArchive, true, false, false, true},
+		{"mark draft", model.DeletedContentPolicyMarkDraft, true, true, true, false},
+		{"incomplete source", model.DeletedContentPolicyDelete, false, true, false, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, cleanup := newTestPipeline(t, false, func(config *model.Configuration, path model.SettingsPath) {
+				config.MarkdownGeneratorSettings(path).DeletedContentPolicy = test.policy
+			})
+			defer cleanup()
+
+			p.run(t, testLinksHandler([]testItem{kept, removed}, false, test.complete))
+			p.run(t, testLinksHandler([]testItem{kept}, false, test.complete))
+
+			content := generated(t, p.contentFS)
+			if _, ok := content[kept.link]; !ok {
+				t.Errorf("content of the item still in the source is missing")
+			}
+			frontmatter, ok := content[removed.link]
+			if ok != test.wantContent {
+				t.Fatalf("content of the removed item exists = %v, want %v", ok, test.wantContent)
+			}
+			if draft, _ := frontmatter["draft"].(bool); ok && draft != test.wantDraft {
+				t.Errorf("removed item draft = %v, want %v", draft, test.wantDraft)
+			}
+			archive := generated(t, afero.NewBasePathFs(p.baseFS, p.markdownSettings.ArchivePath))
+			if _, archived := archive[removed.link]; archived != test.wantArchived {
+				t.Errorf("removed item archived = %v, want %v", archived, test.wantArchived)
+			}
+		})
+	}
+}
+
+func TestDeletedContentDefaults(t *testing.T) {
+	config, _ := model.MakeConfiguration()
+	settings := config.MarkdownGeneratorSettings(model.SettingsPath(model.DefaultSettingsStoreName))
+	if settings.DeletedContentPolicy != model.DeletedContentPolicyIgnore {
+		t.Errorf("default DeletedContentPolicy = %q, want %q", settings.DeletedContentPolicy, model.DeletedContentPolicyIgnore)
+	}
+	if archive := filepath.Clean(string(settings.ArchivePath)) + "/"; strings.HasPrefix(archive, "content/") {
+		t.Errorf("default ArchivePath %q is inside the content directory", settings.ArchivePath)
+	}
+}
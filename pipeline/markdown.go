@@ -2,6 +2,7 @@ package pipeline
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/Machiel/slugify"
 	"github.com/lectio/graph/model"
@@ -14,10 +15,15 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"time"
 )
 
+// bookmarkDateProperties are the properties, in priority order, that may contain a bookmark's date
+var bookmarkDateProperties = []model.PropertyName{"markdown.date", "dropmark.updatedAt", "feed.publishedAt", "feed.updatedAt", "netscape.addDate", "netscape.lastModified", "list.date", "metadata.publishedAt"}
+
 // BookmarksToMarkdown converts a Bookmarks source to Hugo content
 type BookmarksToMarkdown struct {
+	ctx                context.Context
 	config             *model.Configuration
 	settingsPath       model.SettingsPath
 	pipelineURL        *url.URL
@@ -32,6 +38,7 @@ type BookmarksToMarkdown struct {
 	baseFS             afero.Fs
 	contentFS          afero.Fs
 	imageCacheFS       afero.Fs
+	watermark          *source.SyncWatermark
 }
 
 // NewBookmarksToMarkdown returns a new Pipeline for this strategy
@@ -60,10 +67,6 @@ func NewBookmarksToMarkdown(config *model.Configuration, input *model.BookmarksT
 	if err != nil {
 		return result, err
 	}
-	result.linksHandlerParams, err = source.NewLinksAPIHandlerParams(config, result.linksAPISource, result.settingsPath)
-	if err != nil {
-		return result, err
-	}
 
 	result.markdownSettings = config.MarkdownGeneratorSettings(result.settingsPath)
 	result.baseFS = repoMan.FileSystem()
@@ -81,6 +84,26 @@ func NewBookmarksToMarkdown(config *model.Configuration, input *model.BookmarksT
 	return result, nil
 }
 
+// prepare creates the links handler parameters for a single execution, cancelling ctx stops the execution
+func (p *BookmarksToMarkdown) prepare(ctx context.Context) error {
+	var err error
+	p.ctx = ctx
+	if p.input.Incremental {
+		p.watermark, err = p.loadSyncWatermark()
+		if err != nil {
+			return err
+		}
+		p.linksHandlerParams, err = source.NewIncrementalLinksAPIHandlerParams(ctx, p.config, p.linksAPISource, p.settingsPath, p.watermark)
+	} else {
+		p.linksHandlerParams, err = source.NewLinksAPIHandlerParams(ctx, p.config, p.linksAPISource, p.settingsPath)
+	}
+	if err != nil {
+		return err
+	}
+	p.linksHandlerParams.LinksManager().Attachments = source.NewRepositoryAttachments(p.config, p.repoMan, p.markdownSettings.AttachmentsPath)
+	return nil
+}
+
 // IsPipelineExecution satifies model.PipelineExecution interface
 func (p BookmarksToMarkdown) IsPipelineExecution() {
 }
@@ -90,10 +113,19 @@ func (p BookmarksToMarkdown) URL() *url.URL {
 	return p.pipelineURL
 }
 
-// Execute either asynchronously or synchronously runs the pipeline and returns the result
-func (p *BookmarksToMarkdown) Execute() (model.PipelineExecution, error) {
+// Execute either asynchronously or synchronously runs the pipeline and returns the result; the execution stops
+// when ctx is cancelled or the input's timeout is reached
+func (p *BookmarksToMarkdown) Execute(ctx context.Context) (model.PipelineExecution, error) {
 	p.exec.Pipeline = model.PipelineURL(p.pipelineURL.String())
 	p.exec.ExecutionID = GenerateExecutionID()
+	if p.input.Timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(*p.input.Timeout))
+		defer cancel()
+	}
+	if err := p.prepare(ctx); err != nil {
+		return p.exec, err
+	}
 	switch p.input.Strategy {
 	case model.PipelineExecutionStrategyAsynchronous:
 		//TODO: start as go-routine after testing ... go p.execute()
@@ -113,34 +145,44 @@ func (p *BookmarksToMarkdown) frontmatter(context string, bookmark *model.Bookma
 	frontmatter := make(map[string]interface{})
 	frontmatter["archetype"] = "bookmark"
 	frontmatter["source"] = apiSource
-	frontmatter["date"], _ = bookmark.Properties.GetDate("dropmark.updatedAt")
+	frontmatter["date"] = bookmarkDate(bookmark)
 	frontmatter["link"] = bookmark.Link.FinalURL.Text()
+	frontmatter["originalLink"] = bookmark.Link.OriginalURLText
 	frontmatter["linkBrand"] = bookmark.Link.FinalURL.Brand()
 	frontmatter["slug"] = slug
 	frontmatter["title"] = bookmark.Title
 	frontmatter["description"] = bookmark.Summary
+	if len(bookmark.Categorizations) > 0 {
+		frontmatter["categorizations"] = categorizationsFrontMatter(bookmark.Categorizations)
+	}
+	if p.markdownSettings.RedirectChainInFrontMatter && len(bookmark.Link.RedirectChain) > 0 {
+		frontmatter["redirectChain"] = redirectChainFrontMatter(bookmark.Link.RedirectChain)
+	}
 
 	lm := p.linksHandlerParams.LinksManager()
-	lls := lm.LinkSettings
-	if lls.ScoreLinks.Score {
-		scores, err := score.GetSharedCountLinkScoresForURL(p.config.Vault(), bookmark.Link.FinalURL.URL(), lm.HTTPClient(), lls.ScoreLinks.Simulate)
+	scoreLinks := lm.ScoreLinks(bookmark.Link.FinalURL.URL())
+	if scoreLinks.Score {
+		scores, err := score.GetSharedCountLinkScoresForURL(p.config.Vault(), bookmark.Link.FinalURL.URL(), lm.HTTPClient(), scoreLinks.Simulate)
 		if err != nil {
 			p.exec.Activities.AddError(context, "SharedCount.com API error", err.Error())
 		} else if scores != nil {
 			frontmatter["socialScore"] = scores.SharesCount()
-			if lls.ScoreLinks.Simulate {
+			if scoreLinks.Simulate {
 				frontmatter["socialScoreSimulated"] = true
 			}
 		}
 	}
 
 	if bookmark.Taxonomies != nil && len(bookmark.Taxonomies) > 0 {
+		delimiter := p.linksHandlerParams.ContentSettings().Taxonomies.PathDelimiter()
 		for _, taxn := range bookmark.Taxonomies {
 			switch taxonomy := taxn.(type) {
 			case model.FlatTaxonomy:
 				frontmatter[string(taxonomy.Name)] = taxonomy.Taxa
 			case model.HiearchicalTaxonomy:
-				frontmatter[string(taxonomy.Name)] = taxonomy.Taxa
+				// Hugo renders the leaves as terms and the paths as nested terms
+				frontmatter[string(taxonomy.Name)] = taxonomy.Leaves()
+				frontmatter[string(taxonomy.Name)+source.TaxonomyPathsFrontMatterSuffix] = taxonomy.Paths(delimiter)
 			default:
 				panic(fmt.Sprintf("Unknown taxonomy type %T", taxn))
 			}
@@ -151,10 +193,23 @@ func (p *BookmarksToMarkdown) frontmatter(context string, bookmark *model.Bookma
 		_, found := frontmatter[string(key)]
 		if !found {
 			switch string(key) {
-			case "dropmark.updatedAt":
+			case "dropmark.updatedAt", "markdown.date":
 				// skip this, the 'date' is already set
-			case "dropmark.thumbnailURL":
+			case "link.attachment":
+				frontmatter["attachment"] = fmt.Sprintf("%s/%s", p.markdownSettings.AttachmentsURLRel, value)
+			case "link.attachmentMediaType":
+				frontmatter["attachmentMediaType"] = value
+			case "dropmark.thumbnailURL", "metadata.featuredImageURL":
 				thumbnailURL := value.(string)
+				if key == "metadata.featuredImageURL" {
+					// the image found in the page's metadata is only used when the source didn't provide one
+					if _, haveImage := frontmatter["featuredImage"]; haveImage {
+						break
+					}
+					if sourceImage, ok := bookmark.Properties.Get("dropmark.thumbnailURL"); ok && sourceImage != "" {
+						break
+					}
+				}
 				if thumbnailURL != "" {
 					fileName, _, issue := image.Download(thumbnailURL, p, slug)
 					if issue == nil {
@@ -175,31 +230,67 @@ func (p *BookmarksToMarkdown) frontmatter(context string, bookmark *model.Bookma
 	return frontmatter
 }
 
-func (p *BookmarksToMarkdown) write(contentFS afero.Fs, context string, bookmark *model.Bookmark, frontmatter map[string]interface{}) {
+// categorizationsFrontMatter lists the taxa added by categorization rules so editors can see why they were added
+func categorizationsFrontMatter(categorizations []model.Categorization) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(categorizations))
+	for _, categorization := range categorizations {
+		result = append(result, map[string]interface{}{"taxonomy": categorization.Taxonomy, "taxon": categorization.Taxon, "rule": categorization.Rule})
+	}
+	return result
+}
+
+// redirectChainFrontMatter lists each request made while traversing the bookmark's link
+func redirectChainFrontMatter(chain []model.LinkRedirect) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(chain))
+	for _, hop := range chain {
+		entry := map[string]interface{}{"url": hop.URL, "status": hop.StatusCode, "duration": time.Duration(hop.Duration).String()}
+		if hop.Redirect != nil {
+			entry["redirect"] = hop.Redirect.String()
+		}
+		if hop.Error != nil {
+			entry["error"] = *hop.Error
+		}
+		result = append(result, entry)
+	}
+	return result
+}
+
+func bookmarkDate(bookmark *model.Bookmark) time.Time {
+	for _, name := range bookmarkDateProperties {
+		if date, ok := bookmark.Properties.GetDate(name); ok {
+			return date
+		}
+	}
+	return time.Time{}
+}
+
+// write stores the bookmark's markdown, recording and returning the error if it couldn't be written
+func (p *BookmarksToMarkdown) write(contentFS afero.Fs, context string, bookmark *model.Bookmark, frontmatter map[string]interface{}) error {
 	fmBytes, fmErr := yaml.Marshal(frontmatter)
 	if fmErr != nil {
 		p.exec.Activities.AddError(context, "BM2MDERR_MARSHAL_FM", fmt.Sprintf("Unable to marshal front matter: %v", fmErr.Error()))
-		return
+		return fmErr
 	}
 	markdown := bytes.NewBufferString("---\n")
 	_, writeErr := markdown.Write(fmBytes)
 	if writeErr != nil {
 		p.exec.Activities.AddError(context, "BM2MDERR_WRITE_FM", fmt.Sprintf("Unable to write front matter: %v", writeErr.Error()))
-		return
+		return writeErr
 	}
 	_, writeErr = markdown.WriteString("---\n")
 	_, writeErr = markdown.WriteString(string(bookmark.Body))
 	if writeErr != nil {
 		p.exec.Activities.AddError(context, "BM2MDERR_WRITE_BODY", fmt.Sprintf("Unable to write content body: %v", writeErr.Error()))
-		return
+		return writeErr
 	}
 
 	fileName := fmt.Sprintf("%s.md", frontmatter["slug"])
 	writeErr = afero.WriteFile(contentFS, fileName, markdown.Bytes(), p.fileWriteMode)
 	if writeErr != nil {
 		p.exec.Activities.AddError(context, "BM2MDERR_WRITE_MD", fmt.Sprintf("Unable to write markdown content: %v", writeErr.Error()))
-		return
+		return writeErr
 	}
+	return nil
 }
 
 func (p *BookmarksToMarkdown) execute() {
@@ -213,6 +304,10 @@ func (p *BookmarksToMarkdown) execute() {
 		return
 	}
 	p.exec.Bookmarks = bookmarks
+	if p.ctx.Err() != nil {
+		p.cancelled(p.pipelineURL.String())
+		return
+	}
 
 	var written uint
 	pr := p.config.ObservationSettings(p.settingsPath).ProgressReporter()
@@ -220,17 +315,48 @@ func (p *BookmarksToMarkdown) execute() {
 	for index, bookmark := range bookmarks.Content {
 		context := fmt.Sprintf("[%q] bookmark %d", p.pipelineURL.String(), index)
 
+		if p.ctx.Err() != nil {
+			break
+		}
+
 		if len(p.exec.Activities.Errors) > p.markdownSettings.CancelOnWriteErrors {
 			p.exec.Activities.AddError(context, "BM2MDERR_WRITE_ERRORS_LIMIT_REACHED", fmt.Sprintf("Write errors limit exceeded: %d", p.markdownSettings.CancelOnWriteErrors))
 			break
 		}
 
 		frontmatter := p.frontmatter(context, &bookmark)
-		p.write(p.contentFS, context, &bookmark, frontmatter)
+		if p.write(p.contentFS, context, &bookmark, frontmatter) == nil {
+			written++
+			if p.watermark != nil {
+				p.watermark.Written(&bookmark)
+			}
+		}
 		pr.IncrementReportableActivityProgress()
-		written++
 	}
 	pr.CompleteReportableActivityProgress(fmt.Sprintf("Wrote %d of %d bookmarks to %+v", written, len(bookmarks.Content), p.contentFS))
+	if p.ctx.Err() != nil {
+		p.cancelled(p.pipelineURL.String())
+		return
+	}
+
+	p.propagateDeletions(bookmarks)
+
+	// sources which don't tell when their items were updated are harvested in full, there's nothing to sync
+	if p.watermark != nil && p.watermark.Timestamped() {
+		p.reportSync(p.pipelineURL.String())
+		// an item that failed to harvest or write must be retried, which a newer watermark would skip
+		if p.watermark.Failed == 0 && written == uint(len(bookmarks.Content)) && p.watermark.Advanced() {
+			p.saveSyncWatermark(p.pipelineURL.String())
+		}
+	}
+}
+
+// cancelled records that the execution stopped because its context was cancelled or its deadline passed
+func (p *BookmarksToMarkdown) cancelled(context string) {
+	p.exec.Activities.AddHistory(&model.ActivityLog{
+		Context: model.ActivityContext(context),
+		Code:    "BM2MD_CANCELLED",
+		Message: model.ActivityHumanMessage(fmt.Sprintf("Execution cancelled: %v", p.ctx.Err()))})
 }
 
 // FileSystem satisfies image.DownloadStrategy interface
@@ -241,6 +367,10 @@ func (p BookmarksToMarkdown) FileSystem() afero.Fs {
 // PrepareRequest satisfies image.DownloadStrategy interface
 func (p BookmarksToMarkdown) PrepareRequest(client *http.Client, req *http.Request) {
 	req.Header.Set("User-Agent", image.HTTPUserAgent)
+	if p.ctx != nil {
+		// the strategy can't return a new request so replace it in place to carry the cancellation
+		*req = *req.WithContext(p.ctx)
+	}
 }
 
 // HTTPClient satisfies image.DownloadStrategy interface
@@ -0,0 +1,328 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"github.com/lectio/graph/model"
+	"github.com/lectio/graph/source"
+	"github.com/lectio/markdown"
+	"github.com/sony/sonyflake"
+	"github.com/spf13/afero"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testItem is a source item served by testLinksHandler
+type testItem struct {
+	link      string
+	title     string
+	updatedAt time.Time
+	fails     bool
+}
+
+// testLinksHandler harvests items like a source would, without reading anything; only a timestamped source tells
+// the harvester when its items were updated and only a complete one lists all of its links
+func testLinksHandler(items []testItem, timestamped bool, complete bool) source.LinksAPIHandlerFunc {
+	return func(params source.LinksAPIHandlerParams) (*model.Bookmarks, error) {
+		harvester := source.NewBookmarksHarvester(params, params.Source().(*model.BookmarksAPISource))
+		if complete {
+			links := make([]model.URLText, len(items))
+			for i, item := range items {
+				links[i] = model.URLText(item.link)
+			}
+			harvester.SetSourceLinks(links)
+		}
+		var updatedAt func(index int) time.Time
+		if timestamped {
+			updatedAt = func(index int) time.Time {
+				return items[index].updatedAt
+			}
+		}
+		return harvester.HarvestChanged(len(items), updatedAt,
+			func(index int) string {
+				return fmt.Sprintf("test item %d", index)
+			},
+			func(index int, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+				if items[index].fails {
+					errorFn("TEST-0001-FAILED", "item failed")
+					return nil
+				}
+				bookmark := model.Bookmark{
+					Link:       model.BookmarkLink{OriginalURLText: model.URLText(items[index].link)},
+					Title:      model.ContentTitleText(items[index].title),
+					Properties: model.MakeProperties()}
+				if !source.FinalizeBookmark(&bookmark, params.LinksManager(), params.ContentSettings(), errorFn, warnFn) {
+					return nil
+				}
+				return &bookmark
+			}), nil
+	}
+}
+
+// newTestPipeline creates a pipeline which writes into a new directory, removed by the returned function, and
+// harvests with handler instead of the source's own handler
+func newTestPipeline(t *testing.T, incremental bool, configure func(*model.Configuration, model.SettingsPath)) (*BookmarksToMarkdown, func()) {
+	t.Helper()
+	if sflake == nil {
+		// sonyflake can't derive a machine ID on hosts without a private IPv4 address
+		sflake = sonyflake.NewSonyflake(sonyflake.Settings{MachineID: func() (uint16, error) { return 1, nil }})
+	}
+	dir, dirErr := ioutil.TempDir("", "lectio_pipeline_test_")
+	if dirErr != nil {
+		t.Fatalf("unable to create temp dir: %v", dirErr)
+	}
+
+	config, configErr := model.MakeConfiguration()
+	if configErr != nil {
+		t.Fatalf("unable to create configuration: %v", configErr)
+	}
+	path := model.SettingsPath(model.DefaultSettingsStoreName)
+	config.LinkLifecyleSettings(path).TraverseLinks = false
+	config.LinkLifecyleSettings(path).ScoreLinks.Score = false
+	config.ObservationSettings(path).ProgressReporterType = model.ProgressReporterTypeSilent
+	repositories := config.Repositories(path)
+	repositories.All = append(repositories.All, model.FileRepository{Name: "TEST", RootPath: dir})
+	if configure != nil {
+		configure(config, path)
+	}
+
+	input := &model.BookmarksToMarkdownPipelineInput{
+		Strategy:     model.PipelineExecutionStrategySynchronous,
+		BookmarksURL: "https://test.dropmark.com/1.json",
+		Settings:     path,
+		Repository:   "TEST",
+		Incremental:  incremental}
+	p, err := NewBookmarksToMarkdown(config, input)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("unable to create pipeline: %v", err)
+	}
+	return p.(*BookmarksToMarkdown), func() { os.RemoveAll(dir) }
+}
+
+// run executes the pipeline with handler, starting a new execution each time
+func (p *BookmarksToMarkdown) run(t *testing.T, handler source.LinksAPIHandlerFunc) *model.BookmarksToMarkdownPipelineExecution {
+	t.Helper()
+	return p.runWithContext(t, context.Background(), handler)
+}
+
+// runWithContext is like run but executes the pipeline with ctx
+func (p *BookmarksToMarkdown) runWithContext(t *testing.T, ctx context.Context, handler source.LinksAPIHandlerFunc) *model.BookmarksToMarkdownPipelineExecution {
+	t.Helper()
+	p.exec = new(model.BookmarksToMarkdownPipelineExecution)
+	p.exec.Strategy = p.input.Strategy
+	p.linksHandler = handler
+	if _, err := p.Execute(ctx); err != nil {
+		t.Fatalf("Execute returned %v", err)
+	}
+	return p.exec
+}
+
+// generated returns the front matter of the markdown files in fs by their original link
+func generated(t *testing.T, fs afero.Fs) map[string]map[string]interface{} {
+	t.Helper()
+	result := make(map[string]map[string]interface{})
+	walkErr := afero.Walk(fs, "/", func(fileName string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		content, readErr := afero.ReadFile(fs, fileName)
+		if readErr != nil {
+			return readErr
+		}
+		frontmatter := make(map[string]interface{})
+		if _, _, fmErr := markdown.ParseYAMLFrontMatter(content, frontmatter); fmErr != nil {
+			return fmErr
+		}
+		result[fmt.Sprint(frontmatter["originalLink"])] = frontmatter
+		return nil
+	})
+	if walkErr != nil && !os.IsNotExist(walkErr) {
+		t.Fatalf("unable to read generated content: %v", walkErr)
+	}
+	return result
+}
+
+// hasHistory returns true if the execution recorded an activity with code
+func hasHistory(exec *model.BookmarksToMarkdownPipelineExecution, code model.ActivityCode) bool {
+	for _, activity := range exec.Activities.History {
+		if log, ok := activity.(*model.ActivityLog); ok && log.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// readTaxa returns the taxa of the bookmark's taxonomy called name, hierarchical taxonomies as paths
+func readTaxa(bookmark model.Bookmark, name model.TaxonomyName) []string {
+	var result []string
+	for _, taxonomy := range bookmark.Taxonomies {
+		switch t := taxonomy.(type) {
+		case model.FlatTaxonomy:
+			if t.Name == name {
+				for _, taxon := range t.Taxa {
+					result = append(result, string(taxon))
+				}
+			}
+		case model.HiearchicalTaxonomy:
+			if t.Name == name {
+				result = append(result, t.Paths("/")...)
+			}
+		}
+	}
+	return result
+}
+
+func TestRepositoryRoundTrip(t *testing.T) {
+	p, cleanup := newTestPipeline(t, false, func(config *model.Configuration, path model.SettingsPath) {
+		config.ContentSettings(path).Taxonomies.TagPathDelimiter = "/"
+	})
+	defer cleanup()
+
+	written := p.run(t, func(params source.LinksAPIHandlerParams) (*model.Bookmarks, error) {
+		harvester := source.NewBookmarksHarvester(params, params.Source().(*model.BookmarksAPISource))
+		return harvester.Harvest(1,
+			func(index int) string {
+				return "round trip"
+			},
+			func(index int, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+				bookmark := model.Bookmark{
+					Link:       model.BookmarkLink{OriginalURLText: "https://example.com/original"},
+					Title:      "Interoperability Explained",
+					Summary:    "What interoperability means.",
+					Body:       "# Interoperability\n\nSystems exchange records.\n",
+					Properties: model.MakeProperties()}
+				if !source.FinalizeBookmark(&bookmark, params.LinksManager(), params.ContentSettings(), errorFn, warnFn) {
+					return nil
+				}
+				// the link resolved to another URL when it was traversed
+				finalURL, _ := url.Parse("https://example.com/final")
+				bookmark.Link.FinalURL = model.MakeURL(finalURL)
+				bookmark.Properties.Add("dropmark.updatedAt", "2019-09-02T10:30:00Z")
+				bookmark.Properties.Add("custom.note", "kept")
+				bookmark.Properties.Add("custom.featured", true)
+				bookmark.Taxonomies = []model.Taxonomy{
+					model.FlatTaxonomy{Name: "categories", Taxa: []model.TaxonName{"news", "health"}},
+					params.ContentSettings().Taxonomies.TagsTaxonomy("topics", []string{"health/it/interop", "health/policy"}),
+				}
+				return &bookmark
+			}), nil
+	})
+	if len(written.Activities.Errors) > 0 {
+		t.Fatalf("writing reported errors: %+v", written.Activities.Errors)
+	}
+
+	apiSource := &model.BookmarksAPISource{Name: "Repository", APIEndpoint: "repository://TEST"}
+	params, paramsErr := source.NewLinksAPIHandlerParams(context.Background(), p.config, apiSource, p.settingsPath)
+	if paramsErr != nil {
+		t.Fatalf("unable to create params: %v", paramsErr)
+	}
+	read, readErr := source.RepositoryLinks(params)
+	if readErr != nil {
+		t.Fatalf("RepositoryLinks returned %v", readErr)
+	}
+	if len(read.Activities.Errors) > 0 || len(read.Content) != 1 {
+		t.Fatalf("RepositoryLinks read %d bookmarks with errors %+v, want 1", len(read.Content), read.Activities.Errors)
+	}
+
+	bookmark := read.Content[0]
+	if bookmark.Link.OriginalURLText != "https://example.com/original" {
+		t.Errorf("got original link %q", bookmark.Link.OriginalURLText)
+	}
+	if bookmark.Title != "Interoperability Explained" || bookmark.Summary != "What interoperability means." {
+		t.Errorf("got title %q and summary %q", bookmark.Title, bookmark.Summary)
+	}
+	if bookmark.Body != "# Interoperability\n\nSystems exchange records.\n" {
+		t.Errorf("got body %q", bookmark.Body)
+	}
+	properties := []struct {
+		name model.PropertyName
+		want interface{}
+	}{
+		{"markdown.date", "2019-09-02T10:30:00Z"},
+		{"custom.note", "kept"},
+		{"custom.featured", true},
+	}
+	for _, property := range properties {
+		if got, _ := bookmark.Properties.Get(property.name); got != property.want {
+			t.Errorf("got %s %v, want %v", property.name, got, property.want)
+		}
+	}
+	if got := readTaxa(bookmark, "categories"); !reflect.DeepEqual(got, []string{"news", "health"}) {
+		t.Errorf("got categories %q", got)
+	}
+	if got := readTaxa(bookmark, "topics"); !reflect.DeepEqual(got, []string{"health/it/interop", "health/policy"}) {
+		t.Errorf("got topics %q", got)
+	}
+}
+
+func TestExecuteCancelled(t *testing.T) {
+	kept := testItem{link: "https://example.com/kept", title: "Kept", updatedAt: time.Date(2019, 9, 1, 0, 0, 0, 0, time.UTC)}
+	removed := testItem{link: "https://example.com/removed", title: "Removed", updatedAt: time.Date(2019, 9, 2, 0, 0, 0, 0, time.UTC)}
+	added := testItem{link: "https://example.com/added", title: "Added", updatedAt: time.Date(2019, 9, 3, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name             string
+		cancelBefore     bool
+		wantHarvestEntry bool
+	}{
+		{"while harvesting", true, true},
+		{"after harvesting", false, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, cleanup := newTestPipeline(t, true, func(config *model.Configuration, path model.SettingsPath) {
+				config.MarkdownGeneratorSettings(path).DeletedContentPolicy = model.DeletedContentPolicyDelete
+			})
+			defer cleanup()
+			p.run(t, testLinksHandler([]testItem{kept, removed}, true, true))
+			before, err := p.loadSyncWatermark()
+			if err != nil {
+				t.Fatalf("loadSyncWatermark returned %v", err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if test.cancelBefore {
+				cancel()
+			}
+			handler := testLinksHandler([]testItem{kept, added}, true, true)
+			exec := p.runWithContext(t, ctx, func(params source.LinksAPIHandlerParams) (*model.Bookmarks, error) {
+				bookmarks, err := handler(params)
+				cancel()
+				return bookmarks, err
+			})
+
+			if !hasHistory(exec, "BM2MD_CANCELLED") {
+				t.Errorf("cancellation wasn't recorded: %+v", exec.Activities.History)
+			}
+			harvestCancelled := false
+			for _, activity := range exec.Bookmarks.Activities.History {
+				if log, ok := activity.(*model.ActivityLog); ok && log.Code == "HARVEST-CANCELLED" {
+					harvestCancelled = true
+				}
+			}
+			if harvestCancelled != test.wantHarvestEntry {
+				t.Errorf("harvest cancellation recorded = %v, want %v", harvestCancelled, test.wantHarvestEntry)
+			}
+			content := generated(t, p.contentFS)
+			if _, ok := content[added.link]; ok {
+				t.Errorf("content was written after the execution was cancelled")
+			}
+			if _, ok := content[removed.link]; !ok {
+				t.Errorf("deletions were propagated after the execution was cancelled")
+			}
+			after, err := p.loadSyncWatermark()
+			if err != nil {
+				t.Fatalf("loadSyncWatermark returned %v", err)
+			}
+			if !after.Since.Equal(before.Since) {
+				t.Errorf("watermark moved from %v to %v after the execution was cancelled", before.Since, after.Since)
+			}
+		})
+	}
+}
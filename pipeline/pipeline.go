@@ -1,6 +1,7 @@
 package pipeline
 
 import (
+	"context"
 	"github.com/lectio/graph/model"
 	"github.com/sony/sonyflake"
 	"net/url"
@@ -12,7 +13,7 @@ var sflake *sonyflake.Sonyflake = sonyflake.NewSonyflake(sfSettings)
 // Pipeline is an abstract runner of a pre-defined pipeline
 type Pipeline interface {
 	URL() *url.URL
-	Execute() (model.PipelineExecution, error)
+	Execute(ctx context.Context) (model.PipelineExecution, error)
 }
 
 // GenerateExecutionID returns a unique ID
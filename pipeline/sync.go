@@ -0,0 +1,71 @@
+package pipeline
+
+import (
+	"fmt"
+	"github.com/lectio/graph/model"
+	"github.com/lectio/graph/source"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v2"
+	"path/filepath"
+	"time"
+)
+
+// syncState is persisted in the repository after each incremental execution, one file per source
+type syncState struct {
+	Source    model.URLText `yaml:"source"`
+	Watermark time.Time     `yaml:"watermark"`
+}
+
+// syncStateFileName returns the repository path of the sync state for the pipeline's source
+func (p BookmarksToMarkdown) syncStateFileName() string {
+	name := p.config.ContentAddressableStorageHash(string(p.input.BookmarksURL))
+	return filepath.Join(p.markdownSettings.SyncStatePath, name+".yaml")
+}
+
+// loadSyncWatermark reads the watermark of the previous execution, a missing state starts from the beginning
+func (p BookmarksToMarkdown) loadSyncWatermark() (*source.SyncWatermark, error) {
+	fileName := p.syncStateFileName()
+	exists, err := afero.Exists(p.baseFS, fileName)
+	if err != nil || !exists {
+		return source.NewSyncWatermark(time.Time{}), err
+	}
+
+	content, err := afero.ReadFile(p.baseFS, fileName)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read sync state %q: %v", fileName, err.Error())
+	}
+	var state syncState
+	if err = yaml.Unmarshal(content, &state); err != nil {
+		return nil, fmt.Errorf("Unable to parse sync state %q: %v", fileName, err.Error())
+	}
+	return source.NewSyncWatermark(state.Watermark), nil
+}
+
+// saveSyncWatermark persists the newest item seen so the next execution skips what was processed in this one
+func (p *BookmarksToMarkdown) saveSyncWatermark(context string) {
+	fileName := p.syncStateFileName()
+	state := syncState{Source: p.input.BookmarksURL, Watermark: p.watermark.Newest}
+	content, err := yaml.Marshal(state)
+	if err != nil {
+		p.exec.Activities.AddError(context, "BM2MDERR_MARSHAL_SYNC_STATE", fmt.Sprintf("Unable to marshal sync state: %v", err.Error()))
+		return
+	}
+	if err = p.baseFS.MkdirAll(p.markdownSettings.SyncStatePath, p.repoMan.DirPerm()); err != nil {
+		p.exec.Activities.AddError(context, "BM2MDERR_WRITE_SYNC_STATE", fmt.Sprintf("Unable to create sync state directory %q: %v", p.markdownSettings.SyncStatePath, err.Error()))
+		return
+	}
+	if err = afero.WriteFile(p.baseFS, fileName, content, p.fileWriteMode); err != nil {
+		p.exec.Activities.AddError(context, "BM2MDERR_WRITE_SYNC_STATE", fmt.Sprintf("Unable to write sync state %q: %v", fileName, err.Error()))
+	}
+}
+
+// reportSync records how many items were processed versus skipped as unchanged
+func (p *BookmarksToMarkdown) reportSync(context string) {
+	p.exec.Activities.AddHistory(&model.ActivityLog{
+		Context: model.ActivityContext(context),
+		Code:    "BM2MD_INCREMENTAL_SYNC",
+		Message: model.ActivityHumanMessage(fmt.Sprintf("Processed %d and skipped %d unchanged items since %s", p.watermark.Processed, p.watermark.Skipped, p.watermark.Since.Format(time.RFC3339))),
+		Properties: []model.Property{
+			model.NumericProperty{Name: "processed", Value: p.watermark.Processed},
+			model.NumericProperty{Name: "skipped", Value: p.watermark.Skipped}}})
+}
@@ -0,0 +1,74 @@
+package pipeline
+
+import (
+	"github.com/spf13/afero"
+	"testing"
+	"time"
+)
+
+func TestIncrementalSyncWatermark(t *testing.T) {
+	day := func(n int) time.Time {
+		return time.Date(2019, 9, n, 0, 0, 0, 0, time.UTC)
+	}
+	first := testItem{link: "https://example.com/first", title: "First", updatedAt: day(1)}
+	second := testItem{link: "https://example.com/second", title: "Second", updatedAt: day(2)}
+	failed := second
+	failed.fails = true
+
+	tests := []struct {
+		name          string
+		items         []testItem
+		timestamped   bool
+		readOnly      bool
+		wantWatermark time.Time
+		wantReport    bool
+	}{
+		{"newest written item is saved", []testItem{second, first}, true, false, day(2), true},
+		{"failed harvest is not saved", []testItem{first, failed}, true, false, time.Time{}, true},
+		{"failed write is not saved", []testItem{first, second}, true, true, time.Time{}, true},
+		{"source without timestamps is not synced", []testItem{first, second}, false, false, time.Time{}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, cleanup := newTestPipeline(t, true, nil)
+			defer cleanup()
+			if test.readOnly {
+				p.contentFS = afero.NewReadOnlyFs(p.contentFS)
+			}
+
+			exec := p.run(t, testLinksHandler(test.items, test.timestamped, false))
+			if hasHistory(exec, "BM2MD_INCREMENTAL_SYNC") != test.wantReport {
+				t.Errorf("incremental sync reported = %v, want %v", !test.wantReport, test.wantReport)
+			}
+			watermark, err := p.loadSyncWatermark()
+			if err != nil {
+				t.Fatalf("loadSyncWatermark returned %v", err)
+			}
+			if !watermark.Since.Equal(test.wantWatermark) {
+				t.Errorf("saved watermark = %v, want %v", watermark.Since, test.wantWatermark)
+			}
+		})
+	}
+}
+
+func TestIncrementalSyncSkipsUnchanged(t *testing.T) {
+	p, cleanup := newTestPipeline(t, true, nil)
+	defer cleanup()
+
+	items := []testItem{
+		{link: "https://example.com/first", title: "First", updatedAt: time.Date(2019, 9, 1, 0, 0, 0, 0, time.UTC)},
+		{link: "https://example.com/second", title: "Second", updatedAt: time.Date(2019, 9, 2, 0, 0, 0, 0, time.UTC)},
+	}
+	if exec := p.run(t, testLinksHandler(items, true, false)); len(exec.Bookmarks.Content) != 2 {
+		t.Fatalf("first execution wrote %d bookmarks, want 2", len(exec.Bookmarks.Content))
+	}
+
+	items = append(items, testItem{link: "https://example.com/third", title: "Third", updatedAt: time.Date(2019, 9, 3, 0, 0, 0, 0, time.UTC)})
+	exec := p.run(t, testLinksHandler(items, true, false))
+	if len(exec.Bookmarks.Content) != 1 || exec.Bookmarks.Content[0].Title != "Third" {
+		t.Errorf("second execution harvested %+v, want only the third item", exec.Bookmarks.Content)
+	}
+	if p.watermark.Processed != 1 || p.watermark.Skipped != 2 {
+		t.Errorf("second execution processed %d and skipped %d, want 1 and 2", p.watermark.Processed, p.watermark.Skipped)
+	}
+}
@@ -87,29 +87,35 @@ type ComplexityRoot struct {
 	}
 
 	Bookmark struct {
-		Body       func(childComplexity int) int
-		ID         func(childComplexity int) int
-		Link       func(childComplexity int) int
-		Properties func(childComplexity int) int
-		Scores     func(childComplexity int) int
-		Summary    func(childComplexity int) int
-		Taxonomies func(childComplexity int) int
-		Title      func(childComplexity int) int
+		Body            func(childComplexity int) int
+		Categorizations func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Link            func(childComplexity int) int
+		Properties      func(childComplexity int) int
+		Scores          func(childComplexity int) int
+		Summary         func(childComplexity int) int
+		Taxonomies      func(childComplexity int) int
+		Title           func(childComplexity int) int
 	}
 
 	BookmarkLink struct {
+		CanonicalURL    func(childComplexity int) int
 		FinalURL        func(childComplexity int) int
 		ID              func(childComplexity int) int
 		IsValid         func(childComplexity int) int
 		OriginalURLText func(childComplexity int) int
+		RedirectChain   func(childComplexity int) int
+		Rewrites        func(childComplexity int) int
 	}
 
 	Bookmarks struct {
-		Activities func(childComplexity int) int
-		Content    func(childComplexity int) int
-		ID         func(childComplexity int) int
-		Properties func(childComplexity int) int
-		Source     func(childComplexity int) int
+		Activities          func(childComplexity int) int
+		Content             func(childComplexity int) int
+		ID                  func(childComplexity int) int
+		Properties          func(childComplexity int) int
+		Source              func(childComplexity int) int
+		SourceLinks         func(childComplexity int) int
+		SourceLinksComplete func(childComplexity int) int
 	}
 
 	BookmarksAPISource struct {
@@ -125,11 +131,31 @@ type ComplexityRoot struct {
 		Strategy    func(childComplexity int) int
 	}
 
+	Categorization struct {
+		Rule     func(childComplexity int) int
+		Taxon    func(childComplexity int) int
+		Taxonomy func(childComplexity int) int
+	}
+
 	ContentBodySettings struct {
 		AllowFrontmatter              func(childComplexity int) int
+		ExtractMinLength              func(childComplexity int) int
+		ExtractPolicy                 func(childComplexity int) int
 		FrontMatterPropertyNamePrefix func(childComplexity int) int
 	}
 
+	ContentCategorizationRule struct {
+		BodyKeywords         func(childComplexity int) int
+		Domains              func(childComplexity int) int
+		Name                 func(childComplexity int) int
+		PropertyName         func(childComplexity int) int
+		PropertyValueRegExpr func(childComplexity int) int
+		Taxa                 func(childComplexity int) int
+		Taxonomy             func(childComplexity int) int
+		TitleKeywords        func(childComplexity int) int
+		URLRegExpr           func(childComplexity int) int
+	}
+
 	ContentEditActivity struct {
 		Code       func(childComplexity int) int
 		Context    func(childComplexity int) int
@@ -141,15 +167,45 @@ type ComplexityRoot struct {
 		Properties func(childComplexity int) int
 	}
 
+	ContentKeywordsSettings struct {
+		EntitiesTaxonomy func(childComplexity int) int
+		ExtractEntities  func(childComplexity int) int
+		ExtractKeywords  func(childComplexity int) int
+		KeywordsTaxonomy func(childComplexity int) int
+		MaxTerms         func(childComplexity int) int
+		MinFrequency     func(childComplexity int) int
+		StopWords        func(childComplexity int) int
+	}
+
+	ContentMetadataSettings struct {
+		FillMissingDate          func(childComplexity int) int
+		FillMissingFeaturedImage func(childComplexity int) int
+		FillMissingSummary       func(childComplexity int) int
+		FillMissingTitle         func(childComplexity int) int
+	}
+
 	ContentSettings struct {
-		Body    func(childComplexity int) int
-		Store   func(childComplexity int) int
-		Summary func(childComplexity int) int
-		Title   func(childComplexity int) int
+		Body                func(childComplexity int) int
+		CategorizationRules func(childComplexity int) int
+		Keywords            func(childComplexity int) int
+		Metadata            func(childComplexity int) int
+		Store               func(childComplexity int) int
+		Summary             func(childComplexity int) int
+		Taxonomies          func(childComplexity int) int
+		Title               func(childComplexity int) int
 	}
 
 	ContentSummarySettings struct {
-		Policy func(childComplexity int) int
+		ExtractiveMaxLength       func(childComplexity int) int
+		ExtractiveSentences       func(childComplexity int) int
+		FallbackToMetaDescription func(childComplexity int) int
+		Policy                    func(childComplexity int) int
+		SentenceSplitter          func(childComplexity int) int
+	}
+
+	ContentTaxonomySettings struct {
+		Normalizations   func(childComplexity int) int
+		TagPathDelimiter func(childComplexity int) int
 	}
 
 	ContentTitleSettings struct {
@@ -199,10 +255,12 @@ type ComplexityRoot struct {
 	}
 
 	HTTPClientSettings struct {
-		Cache     func(childComplexity int) int
-		Store     func(childComplexity int) int
-		Timeout   func(childComplexity int) int
-		UserAgent func(childComplexity int) int
+		AllowedNetworks      func(childComplexity int) int
+		BlockPrivateNetworks func(childComplexity int) int
+		Cache                func(childComplexity int) int
+		Store                func(childComplexity int) int
+		Timeout              func(childComplexity int) int
+		UserAgent            func(childComplexity int) int
 	}
 
 	HTTPDiskCache struct {
@@ -222,17 +280,58 @@ type ComplexityRoot struct {
 		Taxa func(childComplexity int) int
 	}
 
+	LinkDomainPolicy struct {
+		Domains                                     func(childComplexity int) int
+		DownloadLinkDestinationAttachments          func(childComplexity int) int
+		FollowRedirectsInLinkDestinationHTMLContent func(childComplexity int) int
+		ParseMetaDataInLinkDestinationHTMLContent   func(childComplexity int) int
+		ScoreLinks                                  func(childComplexity int) int
+		Timeout                                     func(childComplexity int) int
+		TraverseLinks                               func(childComplexity int) int
+		UserAgent                                   func(childComplexity int) int
+	}
+
 	LinkLifecyleSettings struct {
+		AllowURLsRegExprs                           func(childComplexity int) int
+		DomainPolicies                              func(childComplexity int) int
 		DownloadLinkDestinationAttachments          func(childComplexity int) int
 		FollowRedirectsInLinkDestinationHTMLContent func(childComplexity int) int
 		IgnoreURLsRegExprs                          func(childComplexity int) int
+		MaxConcurrentHarvests                       func(childComplexity int) int
+		MaxConcurrentHarvestsPerHost                func(childComplexity int) int
 		ParseMetaDataInLinkDestinationHTMLContent   func(childComplexity int) int
 		RemoveParamsFromURLsRegEx                   func(childComplexity int) int
+		Retry                                       func(childComplexity int) int
+		RewriteURLRules                             func(childComplexity int) int
+		Robots                                      func(childComplexity int) int
 		ScoreLinks                                  func(childComplexity int) int
 		Store                                       func(childComplexity int) int
 		TraverseLinks                               func(childComplexity int) int
 	}
 
+	LinkRedirect struct {
+		Duration   func(childComplexity int) int
+		Error      func(childComplexity int) int
+		Redirect   func(childComplexity int) int
+		StatusCode func(childComplexity int) int
+		URL        func(childComplexity int) int
+	}
+
+	LinkRetrySettings struct {
+		CircuitBreakerCooldown func(childComplexity int) int
+		CircuitBreakerFailures func(childComplexity int) int
+		InitialBackoff         func(childComplexity int) int
+		MaxAttempts            func(childComplexity int) int
+		MaxBackoff             func(childComplexity int) int
+		RetryStatusCodes       func(childComplexity int) int
+	}
+
+	LinkRobotsSettings struct {
+		CacheDuration  func(childComplexity int) int
+		HonorRobotsTxt func(childComplexity int) int
+		MaxCrawlDelay  func(childComplexity int) int
+	}
+
 	LinkScoresLifecycleSettings struct {
 		Score    func(childComplexity int) int
 		Simulate func(childComplexity int) int
@@ -252,11 +351,17 @@ type ComplexityRoot struct {
 	}
 
 	MarkdownGeneratorSettings struct {
-		CancelOnWriteErrors func(childComplexity int) int
-		ContentPath         func(childComplexity int) int
-		ImagesPath          func(childComplexity int) int
-		ImagesURLRel        func(childComplexity int) int
-		Store               func(childComplexity int) int
+		ArchivePath                func(childComplexity int) int
+		AttachmentsPath            func(childComplexity int) int
+		AttachmentsURLRel          func(childComplexity int) int
+		CancelOnWriteErrors        func(childComplexity int) int
+		ContentPath                func(childComplexity int) int
+		DeletedContentPolicy       func(childComplexity int) int
+		ImagesPath                 func(childComplexity int) int
+		ImagesURLRel               func(childComplexity int) int
+		RedirectChainInFrontMatter func(childComplexity int) int
+		Store                      func(childComplexity int) int
+		SyncStatePath              func(childComplexity int) int
 	}
 
 	Mutation struct {
@@ -283,6 +388,12 @@ type ComplexityRoot struct {
 		Bookmarks   func(childComplexity int, source model.URLText, settings model.SettingsPath) int
 		Settings    func(childComplexity int, path model.SettingsPath) int
 		Source      func(childComplexity int, source model.URLText) int
+		Sources     func(childComplexity int) int
+	}
+
+	RegisteredSource struct {
+		Name       func(childComplexity int) int
+		URLPattern func(childComplexity int) int
 	}
 
 	Repositories struct {
@@ -299,11 +410,40 @@ type ComplexityRoot struct {
 		Name func(childComplexity int) int
 	}
 
+	SourceSettings struct {
+		AllowStdin         func(childComplexity int) int
+		Columns            func(childComplexity int) int
+		LocalFilesRootPath func(childComplexity int) int
+		OutlinePolicy      func(childComplexity int) int
+		Store              func(childComplexity int) int
+	}
+
+	TabularColumnMapping struct {
+		Date          func(childComplexity int) int
+		Tags          func(childComplexity int) int
+		TagsDelimiter func(childComplexity int) int
+		Title         func(childComplexity int) int
+		URL           func(childComplexity int) int
+	}
+
 	TaxonNode struct {
 		Taxa  func(childComplexity int) int
 		Taxon func(childComplexity int) int
 	}
 
+	TaxonSynonyms struct {
+		Preferred func(childComplexity int) int
+		Synonyms  func(childComplexity int) int
+	}
+
+	TaxonomyNormalization struct {
+		FoldCase        func(childComplexity int) int
+		Slug            func(childComplexity int) int
+		Synonyms        func(childComplexity int) int
+		Taxonomy        func(childComplexity int) int
+		WarnUnknownTaxa func(childComplexity int) int
+	}
+
 	TempFileRepository struct {
 		Name   func(childComplexity int) int
 		Prefix func(childComplexity int) int
@@ -314,6 +454,19 @@ type ComplexityRoot struct {
 		Name  func(childComplexity int) int
 		Value func(childComplexity int) int
 	}
+
+	URLRewrite struct {
+		From func(childComplexity int) int
+		Rule func(childComplexity int) int
+		To   func(childComplexity int) int
+	}
+
+	URLRewriteRule struct {
+		Match       func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Replacement func(childComplexity int) int
+		Type        func(childComplexity int) int
+	}
 }
 
 type MutationResolver interface {
@@ -323,6 +476,7 @@ type MutationResolver interface {
 type QueryResolver interface {
 	AllSettings(ctx context.Context) ([]model.PersistentSettings, error)
 	Settings(ctx context.Context, path model.SettingsPath) ([]model.PersistentSettings, error)
+	Sources(ctx context.Context) ([]model.RegisteredSource, error)
 	Source(ctx context.Context, source model.URLText) (model.ContentSource, error)
 	Bookmarks(ctx context.Context, source model.URLText, settings model.SettingsPath) (*model.Bookmarks, error)
 }
@@ -524,6 +678,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Bookmark.Body(childComplexity), true
 
+	case "Bookmark.Categorizations":
+		if e.complexity.Bookmark.Categorizations == nil {
+			break
+		}
+
+		return e.complexity.Bookmark.Categorizations(childComplexity), true
+
 	case "Bookmark.ID":
 		if e.complexity.Bookmark.ID == nil {
 			break
@@ -573,6 +734,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Bookmark.Title(childComplexity), true
 
+	case "BookmarkLink.CanonicalURL":
+		if e.complexity.BookmarkLink.CanonicalURL == nil {
+			break
+		}
+
+		return e.complexity.BookmarkLink.CanonicalURL(childComplexity), true
+
 	case "BookmarkLink.FinalURL":
 		if e.complexity.BookmarkLink.FinalURL == nil {
 			break
@@ -601,6 +769,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.BookmarkLink.OriginalURLText(childComplexity), true
 
+	case "BookmarkLink.RedirectChain":
+		if e.complexity.BookmarkLink.RedirectChain == nil {
+			break
+		}
+
+		return e.complexity.BookmarkLink.RedirectChain(childComplexity), true
+
+	case "BookmarkLink.Rewrites":
+		if e.complexity.BookmarkLink.Rewrites == nil {
+			break
+		}
+
+		return e.complexity.BookmarkLink.Rewrites(childComplexity), true
+
 	case "Bookmarks.Activities":
 		if e.complexity.Bookmarks.Activities == nil {
 			break
@@ -636,6 +818,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Bookmarks.Source(childComplexity), true
 
+	case "Bookmarks.SourceLinks":
+		if e.complexity.Bookmarks.SourceLinks == nil {
+			break
+		}
+
+		return e.complexity.Bookmarks.SourceLinks(childComplexity), true
+
+	case "Bookmarks.SourceLinksComplete":
+		if e.complexity.Bookmarks.SourceLinksComplete == nil {
+			break
+		}
+
+		return e.complexity.Bookmarks.SourceLinksComplete(childComplexity), true
+
 	case "BookmarksAPISource.APIEndpoint":
 		if e.complexity.BookmarksAPISource.APIEndpoint == nil {
 			break
@@ -685,6 +881,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.BookmarksToMarkdownPipelineExecution.Strategy(childComplexity), true
 
+	case "Categorization.Rule":
+		if e.complexity.Categorization.Rule == nil {
+			break
+		}
+
+		return e.complexity.Categorization.Rule(childComplexity), true
+
+	case "Categorization.Taxon":
+		if e.complexity.Categorization.Taxon == nil {
+			break
+		}
+
+		return e.complexity.Categorization.Taxon(childComplexity), true
+
+	case "Categorization.Taxonomy":
+		if e.complexity.Categorization.Taxonomy == nil {
+			break
+		}
+
+		return e.complexity.Categorization.Taxonomy(childComplexity), true
+
 	case "ContentBodySettings.AllowFrontmatter":
 		if e.complexity.ContentBodySettings.AllowFrontmatter == nil {
 			break
@@ -692,6 +909,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ContentBodySettings.AllowFrontmatter(childComplexity), true
 
+	case "ContentBodySettings.ExtractMinLength":
+		if e.complexity.ContentBodySettings.ExtractMinLength == nil {
+			break
+		}
+
+		return e.complexity.ContentBodySettings.ExtractMinLength(childComplexity), true
+
+	case "ContentBodySettings.ExtractPolicy":
+		if e.complexity.ContentBodySettings.ExtractPolicy == nil {
+			break
+		}
+
+		return e.complexity.ContentBodySettings.ExtractPolicy(childComplexity), true
+
 	case "ContentBodySettings.FrontMatterPropertyNamePrefix":
 		if e.complexity.ContentBodySettings.FrontMatterPropertyNamePrefix == nil {
 			break
@@ -699,6 +930,69 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ContentBodySettings.FrontMatterPropertyNamePrefix(childComplexity), true
 
+	case "ContentCategorizationRule.BodyKeywords":
+		if e.complexity.ContentCategorizationRule.BodyKeywords == nil {
+			break
+		}
+
+		return e.complexity.ContentCategorizationRule.BodyKeywords(childComplexity), true
+
+	case "ContentCategorizationRule.Domains":
+		if e.complexity.ContentCategorizationRule.Domains == nil {
+			break
+		}
+
+		return e.complexity.ContentCategorizationRule.Domains(childComplexity), true
+
+	case "ContentCategorizationRule.Name":
+		if e.complexity.ContentCategorizationRule.Name == nil {
+			break
+		}
+
+		return e.complexity.ContentCategorizationRule.Name(childComplexity), true
+
+	case "ContentCategorizationRule.PropertyName":
+		if e.complexity.ContentCategorizationRule.PropertyName == nil {
+			break
+		}
+
+		return e.complexity.ContentCategorizationRule.PropertyName(childComplexity), true
+
+	case "ContentCategorizationRule.PropertyValueRegExpr":
+		if e.complexity.ContentCategorizationRule.PropertyValueRegExpr == nil {
+			break
+		}
+
+		return e.complexity.ContentCategorizationRule.PropertyValueRegExpr(childComplexity), true
+
+	case "ContentCategorizationRule.Taxa":
+		if e.complexity.ContentCategorizationRule.Taxa == nil {
+			break
+		}
+
+		return e.complexity.ContentCategorizationRule.Taxa(childComplexity), true
+
+	case "ContentCategorizationRule.Taxonomy":
+		if e.complexity.ContentCategorizationRule.Taxonomy == nil {
+			break
+		}
+
+		return e.complexity.ContentCategorizationRule.Taxonomy(childComplexity), true
+
+	case "ContentCategorizationRule.TitleKeywords":
+		if e.complexity.ContentCategorizationRule.TitleKeywords == nil {
+			break
+		}
+
+		return e.complexity.ContentCategorizationRule.TitleKeywords(childComplexity), true
+
+	case "ContentCategorizationRule.URLRegExpr":
+		if e.complexity.ContentCategorizationRule.URLRegExpr == nil {
+			break
+		}
+
+		return e.complexity.ContentCategorizationRule.URLRegExpr(childComplexity), true
+
 	case "ContentEditActivity.Code":
 		if e.complexity.ContentEditActivity.Code == nil {
 			break
@@ -755,6 +1049,83 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ContentEditActivity.Properties(childComplexity), true
 
+	case "ContentKeywordsSettings.EntitiesTaxonomy":
+		if e.complexity.ContentKeywordsSettings.EntitiesTaxonomy == nil {
+			break
+		}
+
+		return e.complexity.ContentKeywordsSettings.EntitiesTaxonomy(childComplexity), true
+
+	case "ContentKeywordsSettings.ExtractEntities":
+		if e.complexity.ContentKeywordsSettings.ExtractEntities == nil {
+			break
+		}
+
+		return e.complexity.ContentKeywordsSettings.ExtractEntities(childComplexity), true
+
+	case "ContentKeywordsSettings.ExtractKeywords":
+		if e.complexity.ContentKeywordsSettings.ExtractKeywords == nil {
+			break
+		}
+
+		return e.complexity.ContentKeywordsSettings.ExtractKeywords(childComplexity), true
+
+	case "ContentKeywordsSettings.KeywordsTaxonomy":
+		if e.complexity.ContentKeywordsSettings.KeywordsTaxonomy == nil {
+			break
+		}
+
+		return e.complexity.ContentKeywordsSettings.KeywordsTaxonomy(childComplexity), true
+
+	case "ContentKeywordsSettings.MaxTerms":
+		if e.complexity.ContentKeywordsSettings.MaxTerms == nil {
+			break
+		}
+
+		return e.complexity.ContentKeywordsSettings.MaxTerms(childComplexity), true
+
+	case "ContentKeywordsSettings.MinFrequency":
+		if e.complexity.ContentKeywordsSettings.MinFrequency == nil {
+			break
+		}
+
+		return e.complexity.ContentKeywordsSettings.MinFrequency(childComplexity), true
+
+	case "ContentKeywordsSettings.StopWords":
+		if e.complexity.ContentKeywordsSettings.StopWords == nil {
+			break
+		}
+
+		return e.complexity.ContentKeywordsSettings.StopWords(childComplexity), true
+
+	case "ContentMetadataSettings.FillMissingDate":
+		if e.complexity.ContentMetadataSettings.FillMissingDate == nil {
+			break
+		}
+
+		return e.complexity.ContentMetadataSettings.FillMissingDate(childComplexity), true
+
+	case "ContentMetadataSettings.FillMissingFeaturedImage":
+		if e.complexity.ContentMetadataSettings.FillMissingFeaturedImage == nil {
+			break
+		}
+
+		return e.complexity.ContentMetadataSettings.FillMissingFeaturedImage(childComplexity), true
+
+	case "ContentMetadataSettings.FillMissingSummary":
+		if e.complexity.ContentMetadataSettings.FillMissingSummary == nil {
+			break
+		}
+
+		return e.complexity.ContentMetadataSettings.FillMissingSummary(childComplexity), true
+
+	case "ContentMetadataSettings.FillMissingTitle":
+		if e.complexity.ContentMetadataSettings.FillMissingTitle == nil {
+			break
+		}
+
+		return e.complexity.ContentMetadataSettings.FillMissingTitle(childComplexity), true
+
 	case "ContentSettings.Body":
 		if e.complexity.ContentSettings.Body == nil {
 			break
@@ -762,6 +1133,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ContentSettings.Body(childComplexity), true
 
+	case "ContentSettings.CategorizationRules":
+		if e.complexity.ContentSettings.CategorizationRules == nil {
+			break
+		}
+
+		return e.complexity.ContentSettings.CategorizationRules(childComplexity), true
+
+	case "ContentSettings.Keywords":
+		if e.complexity.ContentSettings.Keywords == nil {
+			break
+		}
+
+		return e.complexity.ContentSettings.Keywords(childComplexity), true
+
+	case "ContentSettings.Metadata":
+		if e.complexity.ContentSettings.Metadata == nil {
+			break
+		}
+
+		return e.complexity.ContentSettings.Metadata(childComplexity), true
+
 	case "ContentSettings.Store":
 		if e.complexity.ContentSettings.Store == nil {
 			break
@@ -776,6 +1168,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ContentSettings.Summary(childComplexity), true
 
+	case "ContentSettings.Taxonomies":
+		if e.complexity.ContentSettings.Taxonomies == nil {
+			break
+		}
+
+		return e.complexity.ContentSettings.Taxonomies(childComplexity), true
+
 	case "ContentSettings.Title":
 		if e.complexity.ContentSettings.Title == nil {
 			break
@@ -783,6 +1182,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ContentSettings.Title(childComplexity), true
 
+	case "ContentSummarySettings.ExtractiveMaxLength":
+		if e.complexity.ContentSummarySettings.ExtractiveMaxLength == nil {
+			break
+		}
+
+		return e.complexity.ContentSummarySettings.ExtractiveMaxLength(childComplexity), true
+
+	case "ContentSummarySettings.ExtractiveSentences":
+		if e.complexity.ContentSummarySettings.ExtractiveSentences == nil {
+			break
+		}
+
+		return e.complexity.ContentSummarySettings.ExtractiveSentences(childComplexity), true
+
+	case "ContentSummarySettings.FallbackToMetaDescription":
+		if e.complexity.ContentSummarySettings.FallbackToMetaDescription == nil {
+			break
+		}
+
+		return e.complexity.ContentSummarySettings.FallbackToMetaDescription(childComplexity), true
+
 	case "ContentSummarySettings.Policy":
 		if e.complexity.ContentSummarySettings.Policy == nil {
 			break
@@ -790,6 +1210,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ContentSummarySettings.Policy(childComplexity), true
 
+	case "ContentSummarySettings.SentenceSplitter":
+		if e.complexity.ContentSummarySettings.SentenceSplitter == nil {
+			break
+		}
+
+		return e.complexity.ContentSummarySettings.SentenceSplitter(childComplexity), true
+
+	case "ContentTaxonomySettings.Normalizations":
+		if e.complexity.ContentTaxonomySettings.Normalizations == nil {
+			break
+		}
+
+		return e.complexity.ContentTaxonomySettings.Normalizations(childComplexity), true
+
+	case "ContentTaxonomySettings.TagPathDelimiter":
+		if e.complexity.ContentTaxonomySettings.TagPathDelimiter == nil {
+			break
+		}
+
+		return e.complexity.ContentTaxonomySettings.TagPathDelimiter(childComplexity), true
+
 	case "ContentTitleSettings.HyphenatedSuffixPolicy":
 		if e.complexity.ContentTitleSettings.HyphenatedSuffixPolicy == nil {
 			break
@@ -944,6 +1385,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GitHubRepository.URL(childComplexity), true
 
+	case "HTTPClientSettings.AllowedNetworks":
+		if e.complexity.HTTPClientSettings.AllowedNetworks == nil {
+			break
+		}
+
+		return e.complexity.HTTPClientSettings.AllowedNetworks(childComplexity), true
+
+	case "HTTPClientSettings.BlockPrivateNetworks":
+		if e.complexity.HTTPClientSettings.BlockPrivateNetworks == nil {
+			break
+		}
+
+		return e.complexity.HTTPClientSettings.BlockPrivateNetworks(childComplexity), true
+
 	case "HTTPClientSettings.Cache":
 		if e.complexity.HTTPClientSettings.Cache == nil {
 			break
@@ -1028,82 +1483,285 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HiearchicalTaxonomy.Taxa(childComplexity), true
 
-	case "LinkLifecyleSettings.DownloadLinkDestinationAttachments":
-		if e.complexity.LinkLifecyleSettings.DownloadLinkDestinationAttachments == nil {
+	case "LinkDomainPolicy.Domains":
+		if e.complexity.LinkDomainPolicy.Domains == nil {
 			break
 		}
 
-		return e.complexity.LinkLifecyleSettings.DownloadLinkDestinationAttachments(childComplexity), true
+		return e.complexity.LinkDomainPolicy.Domains(childComplexity), true
 
-	case "LinkLifecyleSettings.FollowRedirectsInLinkDestinationHTMLContent":
-		if e.complexity.LinkLifecyleSettings.FollowRedirectsInLinkDestinationHTMLContent == nil {
+	case "LinkDomainPolicy.DownloadLinkDestinationAttachments":
+		if e.complexity.LinkDomainPolicy.DownloadLinkDestinationAttachments == nil {
 			break
 		}
 
-		return e.complexity.LinkLifecyleSettings.FollowRedirectsInLinkDestinationHTMLContent(childComplexity), true
+		return e.complexity.LinkDomainPolicy.DownloadLinkDestinationAttachments(childComplexity), true
 
-	case "LinkLifecyleSettings.IgnoreURLsRegExprs":
-		if e.complexity.LinkLifecyleSettings.IgnoreURLsRegExprs == nil {
+	case "LinkDomainPolicy.FollowRedirectsInLinkDestinationHTMLContent":
+		if e.complexity.LinkDomainPolicy.FollowRedirectsInLinkDestinationHTMLContent == nil {
 			break
 		}
 
-		return e.complexity.LinkLifecyleSettings.IgnoreURLsRegExprs(childComplexity), true
+		return e.complexity.LinkDomainPolicy.FollowRedirectsInLinkDestinationHTMLContent(childComplexity), true
 
-	case "LinkLifecyleSettings.ParseMetaDataInLinkDestinationHTMLContent":
-		if e.complexity.LinkLifecyleSettings.ParseMetaDataInLinkDestinationHTMLContent == nil {
+	case "LinkDomainPolicy.ParseMetaDataInLinkDestinationHTMLContent":
+		if e.complexity.LinkDomainPolicy.ParseMetaDataInLinkDestinationHTMLContent == nil {
 			break
 		}
 
-		return e.complexity.LinkLifecyleSettings.ParseMetaDataInLinkDestinationHTMLContent(childComplexity), true
+		return e.complexity.LinkDomainPolicy.ParseMetaDataInLinkDestinationHTMLContent(childComplexity), true
 
-	case "LinkLifecyleSettings.RemoveParamsFromURLsRegEx":
-		if e.complexity.LinkLifecyleSettings.RemoveParamsFromURLsRegEx == nil {
+	case "LinkDomainPolicy.ScoreLinks":
+		if e.complexity.LinkDomainPolicy.ScoreLinks == nil {
 			break
 		}
 
-		return e.complexity.LinkLifecyleSettings.RemoveParamsFromURLsRegEx(childComplexity), true
+		return e.complexity.LinkDomainPolicy.ScoreLinks(childComplexity), true
 
-	case "LinkLifecyleSettings.ScoreLinks":
-		if e.complexity.LinkLifecyleSettings.ScoreLinks == nil {
+	case "LinkDomainPolicy.Timeout":
+		if e.complexity.LinkDomainPolicy.Timeout == nil {
 			break
 		}
 
-		return e.complexity.LinkLifecyleSettings.ScoreLinks(childComplexity), true
+		return e.complexity.LinkDomainPolicy.Timeout(childComplexity), true
 
-	case "LinkLifecyleSettings.Store":
-		if e.complexity.LinkLifecyleSettings.Store == nil {
+	case "LinkDomainPolicy.TraverseLinks":
+		if e.complexity.LinkDomainPolicy.TraverseLinks == nil {
 			break
 		}
 
-		return e.complexity.LinkLifecyleSettings.Store(childComplexity), true
+		return e.complexity.LinkDomainPolicy.TraverseLinks(childComplexity), true
 
-	case "LinkLifecyleSettings.TraverseLinks":
-		if e.complexity.LinkLifecyleSettings.TraverseLinks == nil {
+	case "LinkDomainPolicy.UserAgent":
+		if e.complexity.LinkDomainPolicy.UserAgent == nil {
 			break
 		}
 
-		return e.complexity.LinkLifecyleSettings.TraverseLinks(childComplexity), true
+		return e.complexity.LinkDomainPolicy.UserAgent(childComplexity), true
 
-	case "LinkScoresLifecycleSettings.Score":
-		if e.complexity.LinkScoresLifecycleSettings.Score == nil {
+	case "LinkLifecyleSettings.AllowURLsRegExprs":
+		if e.complexity.LinkLifecyleSettings.AllowURLsRegExprs == nil {
 			break
 		}
 
-		return e.complexity.LinkScoresLifecycleSettings.Score(childComplexity), true
+		return e.complexity.LinkLifecyleSettings.AllowURLsRegExprs(childComplexity), true
 
-	case "LinkScoresLifecycleSettings.Simulate":
-		if e.complexity.LinkScoresLifecycleSettings.Simulate == nil {
+	case "LinkLifecyleSettings.DomainPolicies":
+		if e.complexity.LinkLifecyleSettings.DomainPolicies == nil {
 			break
 		}
 
-		return e.complexity.LinkScoresLifecycleSettings.Simulate(childComplexity), true
+		return e.complexity.LinkLifecyleSettings.DomainPolicies(childComplexity), true
 
-	case "LinkedInLinkScorer.HumanName":
-		if e.complexity.LinkedInLinkScorer.HumanName == nil {
+	case "LinkLifecyleSettings.DownloadLinkDestinationAttachments":
+		if e.complexity.LinkLifecyleSettings.DownloadLinkDestinationAttachments == nil {
 			break
 		}
 
-		return e.complexity.LinkedInLinkScorer.HumanName(childComplexity), true
+		return e.complexity.LinkLifecyleSettings.DownloadLinkDestinationAttachments(childComplexity), true
+
+	case "LinkLifecyleSettings.FollowRedirectsInLinkDestinationHTMLContent":
+		if e.complexity.LinkLifecyleSettings.FollowRedirectsInLinkDestinationHTMLContent == nil {
+			break
+		}
+
+		return e.complexity.LinkLifecyleSettings.FollowRedirectsInLinkDestinationHTMLContent(childComplexity), true
+
+	case "LinkLifecyleSettings.IgnoreURLsRegExprs":
+		if e.complexity.LinkLifecyleSettings.IgnoreURLsRegExprs == nil {
+			break
+		}
+
+		return e.complexity.LinkLifecyleSettings.IgnoreURLsRegExprs(childComplexity), true
+
+	case "LinkLifecyleSettings.MaxConcurrentHarvests":
+		if e.complexity.LinkLifecyleSettings.MaxConcurrentHarvests == nil {
+			break
+		}
+
+		return e.complexity.LinkLifecyleSettings.MaxConcurrentHarvests(childComplexity), true
+
+	case "LinkLifecyleSettings.MaxConcurrentHarvestsPerHost":
+		if e.complexity.LinkLifecyleSettings.MaxConcurrentHarvestsPerHost == nil {
+			break
+		}
+
+		return e.complexity.LinkLifecyleSettings.MaxConcurrentHarvestsPerHost(childComplexity), true
+
+	case "LinkLifecyleSettings.ParseMetaDataInLinkDestinationHTMLContent":
+		if e.complexity.LinkLifecyleSettings.ParseMetaDataInLinkDestinationHTMLContent == nil {
+			break
+		}
+
+		return e.complexity.LinkLifecyleSettings.ParseMetaDataInLinkDestinationHTMLContent(childComplexity), true
+
+	case "LinkLifecyleSettings.RemoveParamsFromURLsRegEx":
+		if e.complexity.LinkLifecyleSettings.RemoveParamsFromURLsRegEx == nil {
+			break
+		}
+
+		return e.complexity.LinkLifecyleSettings.RemoveParamsFromURLsRegEx(childComplexity), true
+
+	case "LinkLifecyleSettings.Retry":
+		if e.complexity.LinkLifecyleSettings.Retry == nil {
+			break
+		}
+
+		return e.complexity.LinkLifecyleSettings.Retry(childComplexity), true
+
+	case "LinkLifecyleSettings.RewriteURLRules":
+		if e.complexity.LinkLifecyleSettings.RewriteURLRules == nil {
+			break
+		}
+
+		return e.complexity.LinkLifecyleSettings.RewriteURLRules(childComplexity), true
+
+	case "LinkLifecyleSettings.Robots":
+		if e.complexity.LinkLifecyleSettings.Robots == nil {
+			break
+		}
+
+		return e.complexity.LinkLifecyleSettings.Robots(childComplexity), true
+
+	case "LinkLifecyleSettings.ScoreLinks":
+		if e.complexity.LinkLifecyleSettings.ScoreLinks == nil {
+			break
+		}
+
+		return e.complexity.LinkLifecyleSettings.ScoreLinks(childComplexity), true
+
+	case "LinkLifecyleSettings.Store":
+		if e.complexity.LinkLifecyleSettings.Store == nil {
+			break
+		}
+
+		return e.complexity.LinkLifecyleSettings.Store(childComplexity), true
+
+	case "LinkLifecyleSettings.TraverseLinks":
+		if e.complexity.LinkLifecyleSettings.TraverseLinks == nil {
+			break
+		}
+
+		return e.complexity.LinkLifecyleSettings.TraverseLinks(childComplexity), true
+
+	case "LinkRedirect.Duration":
+		if e.complexity.LinkRedirect.Duration == nil {
+			break
+		}
+
+		return e.complexity.LinkRedirect.Duration(childComplexity), true
+
+	case "LinkRedirect.Error":
+		if e.complexity.LinkRedirect.Error == nil {
+			break
+		}
+
+		return e.complexity.LinkRedirect.Error(childComplexity), true
+
+	case "LinkRedirect.Redirect":
+		if e.complexity.LinkRedirect.Redirect == nil {
+			break
+		}
+
+		return e.complexity.LinkRedirect.Redirect(childComplexity), true
+
+	case "LinkRedirect.StatusCode":
+		if e.complexity.LinkRedirect.StatusCode == nil {
+			break
+		}
+
+		return e.complexity.LinkRedirect.StatusCode(childComplexity), true
+
+	case "LinkRedirect.URL":
+		if e.complexity.LinkRedirect.URL == nil {
+			break
+		}
+
+		return e.complexity.LinkRedirect.URL(childComplexity), true
+
+	case "LinkRetrySettings.CircuitBreakerCooldown":
+		if e.complexity.LinkRetrySettings.CircuitBreakerCooldown == nil {
+			break
+		}
+
+		return e.complexity.LinkRetrySettings.CircuitBreakerCooldown(childComplexity), true
+
+	case "LinkRetrySettings.CircuitBreakerFailures":
+		if e.complexity.LinkRetrySettings.CircuitBreakerFailures == nil {
+			break
+		}
+
+		return e.complexity.LinkRetrySettings.CircuitBreakerFailures(childComplexity), true
+
+	case "LinkRetrySettings.InitialBackoff":
+		if e.complexity.LinkRetrySettings.InitialBackoff == nil {
+			break
+		}
+
+		return e.complexity.LinkRetrySettings.InitialBackoff(childComplexity), true
+
+	case "LinkRetrySettings.MaxAttempts":
+		if e.complexity.LinkRetrySettings.MaxAttempts == nil {
+			break
+		}
+
+		return e.complexity.LinkRetrySettings.MaxAttempts(childComplexity), true
+
+	case "LinkRetrySettings.MaxBackoff":
+		if e.complexity.LinkRetrySettings.MaxBackoff == nil {
+			break
+		}
+
+		return e.complexity.LinkRetrySettings.MaxBackoff(childComplexity), true
+
+	case "LinkRetrySettings.RetryStatusCodes":
+		if e.complexity.LinkRetrySettings.RetryStatusCodes == nil {
+			break
+		}
+
+		return e.complexity.LinkRetrySettings.RetryStatusCodes(childComplexity), true
+
+	case "LinkRobotsSettings.CacheDuration":
+		if e.complexity.LinkRobotsSettings.CacheDuration == nil {
+			break
+		}
+
+		return e.complexity.LinkRobotsSettings.CacheDuration(childComplexity), true
+
+	case "LinkRobotsSettings.HonorRobotsTxt":
+		if e.complexity.LinkRobotsSettings.HonorRobotsTxt == nil {
+			break
+		}
+
+		return e.complexity.LinkRobotsSettings.HonorRobotsTxt(childComplexity), true
+
+	case "LinkRobotsSettings.MaxCrawlDelay":
+		if e.complexity.LinkRobotsSettings.MaxCrawlDelay == nil {
+			break
+		}
+
+		return e.complexity.LinkRobotsSettings.MaxCrawlDelay(childComplexity), true
+
+	case "LinkScoresLifecycleSettings.Score":
+		if e.complexity.LinkScoresLifecycleSettings.Score == nil {
+			break
+		}
+
+		return e.complexity.LinkScoresLifecycleSettings.Score(childComplexity), true
+
+	case "LinkScoresLifecycleSettings.Simulate":
+		if e.complexity.LinkScoresLifecycleSettings.Simulate == nil {
+			break
+		}
+
+		return e.complexity.LinkScoresLifecycleSettings.Simulate(childComplexity), true
+
+	case "LinkedInLinkScorer.HumanName":
+		if e.complexity.LinkedInLinkScorer.HumanName == nil {
+			break
+		}
+
+		return e.complexity.LinkedInLinkScorer.HumanName(childComplexity), true
 
 	case "LinkedInLinkScorer.MachineName":
 		if e.complexity.LinkedInLinkScorer.MachineName == nil {
@@ -1147,6 +1805,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LinkedInLinkScores.TargetURL(childComplexity), true
 
+	case "MarkdownGeneratorSettings.ArchivePath":
+		if e.complexity.MarkdownGeneratorSettings.ArchivePath == nil {
+			break
+		}
+
+		return e.complexity.MarkdownGeneratorSettings.ArchivePath(childComplexity), true
+
+	case "MarkdownGeneratorSettings.AttachmentsPath":
+		if e.complexity.MarkdownGeneratorSettings.AttachmentsPath == nil {
+			break
+		}
+
+		return e.complexity.MarkdownGeneratorSettings.AttachmentsPath(childComplexity), true
+
+	case "MarkdownGeneratorSettings.AttachmentsURLRel":
+		if e.complexity.MarkdownGeneratorSettings.AttachmentsURLRel == nil {
+			break
+		}
+
+		return e.complexity.MarkdownGeneratorSettings.AttachmentsURLRel(childComplexity), true
+
 	case "MarkdownGeneratorSettings.CancelOnWriteErrors":
 		if e.complexity.MarkdownGeneratorSettings.CancelOnWriteErrors == nil {
 			break
@@ -1161,6 +1840,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.MarkdownGeneratorSettings.ContentPath(childComplexity), true
 
+	case "MarkdownGeneratorSettings.DeletedContentPolicy":
+		if e.complexity.MarkdownGeneratorSettings.DeletedContentPolicy == nil {
+			break
+		}
+
+		return e.complexity.MarkdownGeneratorSettings.DeletedContentPolicy(childComplexity), true
+
 	case "MarkdownGeneratorSettings.ImagesPath":
 		if e.complexity.MarkdownGeneratorSettings.ImagesPath == nil {
 			break
@@ -1175,6 +1861,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.MarkdownGeneratorSettings.ImagesURLRel(childComplexity), true
 
+	case "MarkdownGeneratorSettings.RedirectChainInFrontMatter":
+		if e.complexity.MarkdownGeneratorSettings.RedirectChainInFrontMatter == nil {
+			break
+		}
+
+		return e.complexity.MarkdownGeneratorSettings.RedirectChainInFrontMatter(childComplexity), true
+
 	case "MarkdownGeneratorSettings.Store":
 		if e.complexity.MarkdownGeneratorSettings.Store == nil {
 			break
@@ -1182,6 +1875,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.MarkdownGeneratorSettings.Store(childComplexity), true
 
+	case "MarkdownGeneratorSettings.SyncStatePath":
+		if e.complexity.MarkdownGeneratorSettings.SyncStatePath == nil {
+			break
+		}
+
+		return e.complexity.MarkdownGeneratorSettings.SyncStatePath(childComplexity), true
+
 	case "Mutation.ExecuteBookmarksToMarkdownPipeline":
 		if e.complexity.Mutation.ExecuteBookmarksToMarkdownPipeline == nil {
 			break
@@ -1284,6 +1984,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Source(childComplexity, args["source"].(model.URLText)), true
 
+	case "Query.Sources":
+		if e.complexity.Query.Sources == nil {
+			break
+		}
+
+		return e.complexity.Query.Sources(childComplexity), true
+
+	case "RegisteredSource.Name":
+		if e.complexity.RegisteredSource.Name == nil {
+			break
+		}
+
+		return e.complexity.RegisteredSource.Name(childComplexity), true
+
+	case "RegisteredSource.URLPattern":
+		if e.complexity.RegisteredSource.URLPattern == nil {
+			break
+		}
+
+		return e.complexity.RegisteredSource.URLPattern(childComplexity), true
+
 	case "Repositories.All":
 		if e.complexity.Repositories.All == nil {
 			break
@@ -1319,6 +2040,76 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SettingsStore.Name(childComplexity), true
 
+	case "SourceSettings.AllowStdin":
+		if e.complexity.SourceSettings.AllowStdin == nil {
+			break
+		}
+
+		return e.complexity.SourceSettings.AllowStdin(childComplexity), true
+
+	case "SourceSettings.Columns":
+		if e.complexity.SourceSettings.Columns == nil {
+			break
+		}
+
+		return e.complexity.SourceSettings.Columns(childComplexity), true
+
+	case "SourceSettings.LocalFilesRootPath":
+		if e.complexity.SourceSettings.LocalFilesRootPath == nil {
+			break
+		}
+
+		return e.complexity.SourceSettings.LocalFilesRootPath(childComplexity), true
+
+	case "SourceSettings.OutlinePolicy":
+		if e.complexity.SourceSettings.OutlinePolicy == nil {
+			break
+		}
+
+		return e.complexity.SourceSettings.OutlinePolicy(childComplexity), true
+
+	case "SourceSettings.Store":
+		if e.complexity.SourceSettings.Store == nil {
+			break
+		}
+
+		return e.complexity.SourceSettings.Store(childComplexity), true
+
+	case "TabularColumnMapping.Date":
+		if e.complexity.TabularColumnMapping.Date == nil {
+			break
+		}
+
+		return e.complexity.TabularColumnMapping.Date(childComplexity), true
+
+	case "TabularColumnMapping.Tags":
+		if e.complexity.TabularColumnMapping.Tags == nil {
+			break
+		}
+
+		return e.complexity.TabularColumnMapping.Tags(childComplexity), true
+
+	case "TabularColumnMapping.TagsDelimiter":
+		if e.complexity.TabularColumnMapping.TagsDelimiter == nil {
+			break
+		}
+
+		return e.complexity.TabularColumnMapping.TagsDelimiter(childComplexity), true
+
+	case "TabularColumnMapping.Title":
+		if e.complexity.TabularColumnMapping.Title == nil {
+			break
+		}
+
+		return e.complexity.TabularColumnMapping.Title(childComplexity), true
+
+	case "TabularColumnMapping.URL":
+		if e.complexity.TabularColumnMapping.URL == nil {
+			break
+		}
+
+		return e.complexity.TabularColumnMapping.URL(childComplexity), true
+
 	case "TaxonNode.Taxa":
 		if e.complexity.TaxonNode.Taxa == nil {
 			break
@@ -1333,6 +2124,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TaxonNode.Taxon(childComplexity), true
 
+	case "TaxonSynonyms.Preferred":
+		if e.complexity.TaxonSynonyms.Preferred == nil {
+			break
+		}
+
+		return e.complexity.TaxonSynonyms.Preferred(childComplexity), true
+
+	case "TaxonSynonyms.Synonyms":
+		if e.complexity.TaxonSynonyms.Synonyms == nil {
+			break
+		}
+
+		return e.complexity.TaxonSynonyms.Synonyms(childComplexity), true
+
+	case "TaxonomyNormalization.FoldCase":
+		if e.complexity.TaxonomyNormalization.FoldCase == nil {
+			break
+		}
+
+		return e.complexity.TaxonomyNormalization.FoldCase(childComplexity), true
+
+	case "TaxonomyNormalization.Slug":
+		if e.complexity.TaxonomyNormalization.Slug == nil {
+			break
+		}
+
+		return e.complexity.TaxonomyNormalization.Slug(childComplexity), true
+
+	case "TaxonomyNormalization.Synonyms":
+		if e.complexity.TaxonomyNormalization.Synonyms == nil {
+			break
+		}
+
+		return e.complexity.TaxonomyNormalization.Synonyms(childComplexity), true
+
+	case "TaxonomyNormalization.Taxonomy":
+		if e.complexity.TaxonomyNormalization.Taxonomy == nil {
+			break
+		}
+
+		return e.complexity.TaxonomyNormalization.Taxonomy(childComplexity), true
+
+	case "TaxonomyNormalization.WarnUnknownTaxa":
+		if e.complexity.TaxonomyNormalization.WarnUnknownTaxa == nil {
+			break
+		}
+
+		return e.complexity.TaxonomyNormalization.WarnUnknownTaxa(childComplexity), true
+
 	case "TempFileRepository.Name":
 		if e.complexity.TempFileRepository.Name == nil {
 			break
@@ -1368,11 +2208,60 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TextProperty.Value(childComplexity), true
 
-	}
-	return 0, false
-}
+	case "URLRewrite.From":
+		if e.complexity.URLRewrite.From == nil {
+			break
+		}
 
-func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+		return e.complexity.URLRewrite.From(childComplexity), true
+
+	case "URLRewrite.Rule":
+		if e.complexity.URLRewrite.Rule == nil {
+			break
+		}
+
+		return e.complexity.URLRewrite.Rule(childComplexity), true
+
+	case "URLRewrite.To":
+		if e.complexity.URLRewrite.To == nil {
+			break
+		}
+
+		return e.complexity.URLRewrite.To(childComplexity), true
+
+	case "URLRewriteRule.Match":
+		if e.complexity.URLRewriteRule.Match == nil {
+			break
+		}
+
+		return e.complexity.URLRewriteRule.Match(childComplexity), true
+
+	case "URLRewriteRule.Name":
+		if e.complexity.URLRewriteRule.Name == nil {
+			break
+		}
+
+		return e.complexity.URLRewriteRule.Name(childComplexity), true
+
+	case "URLRewriteRule.Replacement":
+		if e.complexity.URLRewriteRule.Replacement == nil {
+			break
+		}
+
+		return e.complexity.URLRewriteRule.Replacement(childComplexity), true
+
+	case "URLRewriteRule.Type":
+		if e.complexity.URLRewriteRule.Type == nil {
+			break
+		}
+
+		return e.complexity.URLRewriteRule.Type(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
 	ec := executionContext{graphql.GetRequestContext(ctx), e}
 
 	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
@@ -1510,6 +2399,7 @@ scalar Timestamp
 	&ast.Source{Name: "schema/pipeline.graphql", Input: `scalar PipelineExecutionID
 scalar PipelineParamName
 scalar PipelineURL
+scalar PipelineTimeoutDuration
 
 input PipelineParamInput {
     name: PipelineParamName!
@@ -1540,6 +2430,8 @@ input BookmarksToMarkdownPipelineInput {
     bookmarksURL: URLText!
     settings: SettingsPath! = "DEFAULT"
     repository: RepositoryName! = "TEMP"
+    incremental: Boolean! = false
+    timeout: PipelineTimeoutDuration
 }
 
 type BookmarksToMarkdownPipelineExecution implements PipelineExecution {
@@ -1550,12 +2442,25 @@ type BookmarksToMarkdownPipelineExecution implements PipelineExecution {
     activities: Activities!
 }
 
+enum DeletedContentPolicy {
+    Ignore
+    Delete
+    Archive
+    MarkDraft
+}
+
 type MarkdownGeneratorSettings implements PersistentSettings {
     store: SettingsStore!
     cancelOnWriteErrors: Int!
     contentPath: RelativeDirectoryPath!
     imagesPath: RelativeDirectoryPath!
     imagesURLRel: URLText!
+    attachmentsPath: RelativeDirectoryPath!
+    attachmentsURLRel: URLText!
+    syncStatePath: RelativeDirectoryPath!
+    deletedContentPolicy: DeletedContentPolicy!
+    archivePath: RelativeDirectoryPath!
+    redirectChainInFrontMatter: Boolean!
 }
 
 `},
@@ -1675,11 +2580,39 @@ scalar ContentTitleText
 scalar ContentSummaryText
 scalar ContentBodyText
 
+type URLRewrite {
+    rule: NameText!
+    from: URLText!
+    to: URLText!
+}
+
+enum LinkRedirectType {
+    HTTP
+    MetaRefresh
+}
+
+type LinkRedirect {
+    url: URLText!
+    statusCode: Int!
+    redirect: LinkRedirectType
+    duration: LinkRedirectDuration!
+    error: String
+}
+
 type BookmarkLink implements Link {
     id: ID!
     originalURLText: URLText!
 	finalURL: URL
     isValid: Boolean!
+    rewrites: [URLRewrite!]
+    canonicalURL: URL
+    redirectChain: [LinkRedirect!]
+}
+
+type Categorization {
+    taxonomy: TaxonomyName!
+    taxon: TaxonName!
+    rule: NameText!
 }
 
 type Bookmark implements Content {
@@ -1691,6 +2624,7 @@ type Bookmark implements Content {
     taxonomies: [Taxonomy!]!
     properties: Properties
     scores: LinkScores
+    categorizations: [Categorization!]
 }
 
 type BookmarksAPISource implements ContentSource & APISource {
@@ -1704,11 +2638,19 @@ type Bookmarks implements ContentCollection {
     content: [Bookmark!]
     activities: Activities!
     properties: Properties
+    sourceLinks: [URLText!]
+    sourceLinksComplete: Boolean!
+}
+
+type RegisteredSource {
+    name: NameText!
+    urlPattern: RegularExpression
 }
 
 type Query {
     allSettings : [PersistentSettings]
     settings(path: SettingsPath!) : [PersistentSettings]
+    sources : [RegisteredSource!]
     source(source: URLText!) : ContentSource
     bookmarks(source: URLText!, settings: SettingsPath! = "DEFAULT") : Bookmarks
 }
@@ -1789,6 +2731,9 @@ scalar SettingsStoreName
 scalar RegularExpression
 
 scalar HTTPClientTimeoutDuration
+scalar LinkRetryDuration
+scalar LinkRobotsDuration
+scalar LinkRedirectDuration
 scalar HTTPCacheName
 
 type SettingsStore {
@@ -1821,6 +2766,8 @@ type HTTPClientSettings implements PersistentSettings {
     userAgent: String!
     timeout: HTTPClientTimeoutDuration!
     cache: HTTPCache!
+    blockPrivateNetworks: Boolean!
+    allowedNetworks: [String!]
 } 
 
 type LinkScoresLifecycleSettings {
@@ -1828,15 +2775,64 @@ type LinkScoresLifecycleSettings {
     simulate: Boolean!
 }
 
+type LinkRetrySettings {
+    maxAttempts: Int!
+    initialBackoff: LinkRetryDuration!
+    maxBackoff: LinkRetryDuration!
+    retryStatusCodes: [Int!]
+    circuitBreakerFailures: Int!
+    circuitBreakerCooldown: LinkRetryDuration!
+}
+
+enum URLRewriteRuleType {
+    RegExReplace
+    ReplaceHost
+    AMPToCanonical
+    ForceHTTPS
+    StripFragment
+    SortQueryParams
+}
+
+type URLRewriteRule {
+    name: NameText!
+    type: URLRewriteRuleType!
+    match: RegularExpression
+    replacement: String
+}
+
+type LinkRobotsSettings {
+    honorRobotsTxt: Boolean!
+    cacheDuration: LinkRobotsDuration!
+    maxCrawlDelay: LinkRobotsDuration!
+}
+
+type LinkDomainPolicy {
+    domains: [String!]!
+    traverseLinks: Boolean
+    scoreLinks: LinkScoresLifecycleSettings
+    followRedirectsInLinkDestinationHTMLContent: Boolean
+    parseMetaDataInLinkDestinationHTMLContent: Boolean
+    downloadLinkDestinationAttachments: Boolean
+    userAgent: String
+    timeout: HTTPClientTimeoutDuration
+}
+
 type LinkLifecyleSettings implements PersistentSettings {
     store: SettingsStore!
     traverseLinks: Boolean!
     scoreLinks: LinkScoresLifecycleSettings!
     ignoreURLsRegExprs: [RegularExpression]
+    allowURLsRegExprs: [RegularExpression]
     removeParamsFromURLsRegEx: [RegularExpression]
+    rewriteURLRules: [URLRewriteRule!]
     followRedirectsInLinkDestinationHTMLContent: Boolean!
     parseMetaDataInLinkDestinationHTMLContent: Boolean!
     downloadLinkDestinationAttachments: Boolean!
+    maxConcurrentHarvests: Int!
+    maxConcurrentHarvestsPerHost: Int!
+    retry: LinkRetrySettings!
+    robots: LinkRobotsSettings!
+    domainPolicies: [LinkDomainPolicy!]
 }
 
 enum ContentTitleSuffixPolicy {
@@ -1852,15 +2848,68 @@ type ContentTitleSettings {
 enum ContentSummaryPolicy {
     AlwaysUseFirstSentenceOfContentBody
     UseFirstSentenceOfContentBodyIfEmpty
+    AlwaysUseExtractiveSummaryOfContentBody
+    UseExtractiveSummaryOfContentBodyIfEmpty
+}
+
+enum ContentSentenceSplitter {
+    RegEx
+    NLP
 }
 
 type ContentSummarySettings {
     policy: ContentSummaryPolicy!
+    sentenceSplitter: ContentSentenceSplitter!
+    extractiveSentences: Int!
+    extractiveMaxLength: Int!
+    fallbackToMetaDescription: Boolean!
+}
+
+enum ContentBodyExtractPolicy {
+    Never
+    ExtractIfEmpty
+    AlwaysExtract
 }
 
 type ContentBodySettings {
     allowFrontmatter: Boolean!
     frontMatterPropertyNamePrefix: String!
+    extractPolicy: ContentBodyExtractPolicy!
+    extractMinLength: Int!
+}
+
+type ContentMetadataSettings {
+    fillMissingTitle: Boolean!
+    fillMissingSummary: Boolean!
+    fillMissingDate: Boolean!
+    fillMissingFeaturedImage: Boolean!
+}
+
+type ContentTaxonomySettings {
+    tagPathDelimiter: String!
+    normalizations: [TaxonomyNormalization!]
+}
+
+type ContentKeywordsSettings {
+    extractKeywords: Boolean!
+    keywordsTaxonomy: TaxonomyName!
+    extractEntities: Boolean!
+    entitiesTaxonomy: TaxonomyName!
+    maxTerms: Int!
+    minFrequency: Int!
+    stopWords: [String!]
+}
+
+type ContentCategorizationRule {
+    name: NameText!
+    domains: [String!]
+    urlRegExpr: RegularExpression
+    titleKeywords: [String!]
+    bodyKeywords: [String!]
+    propertyName: PropertyName
+    propertyValueRegExpr: RegularExpression
+    taxonomy: TaxonomyName!
+    taxa: [TaxonName!]!
 }
 
 type ContentSettings implements PersistentSettings {
@@ -1868,6 +2917,10 @@ type ContentSettings implements PersistentSettings {
     title: ContentTitleSettings!
     summary: ContentSummarySettings!
     body: ContentBodySettings!
+    metadata: ContentMetadataSettings!
+    taxonomies: ContentTaxonomySettings!
+    keywords: ContentKeywordsSettings!
+    categorizationRules: [ContentCategorizationRule!]
 }
 
 enum ProgressReporterType {
@@ -1879,6 +2932,27 @@ enum ProgressReporterType {
 type ObservationSettings implements PersistentSettings {
     store: SettingsStore!
     progressReporterType: ProgressReporterType!    
+}
+
+enum SourceOutlinePolicy {
+    BookmarkWebsites
+    HarvestFeeds
+}
+
+type TabularColumnMapping {
+    url: String!
+    title: String!
+    tags: String!
+    tagsDelimiter: String!
+    date: String!
+}
+
+type SourceSettings implements PersistentSettings {
+    store: SettingsStore!
+    outlinePolicy: SourceOutlinePolicy!
+    columns: TabularColumnMapping!
+    localFilesRootPath: String!
+    allowStdin: Boolean!
 }`},
 	&ast.Source{Name: "schema/taxonomy.graphql", Input: `scalar TaxonomyName
 scalar TaxonName  # Taxonomy uses taxonomic units, known as taxa (singular taxon).
@@ -1887,6 +2961,19 @@ interface Taxonomy {
     name: TaxonomyName!
 }
 
+type TaxonSynonyms {
+    preferred: TaxonName!
+    synonyms: [TaxonName!]
+}
+
+type TaxonomyNormalization {
+    taxonomy: TaxonomyName!
+    foldCase: Boolean!
+    slug: Boolean!
+    synonyms: [TaxonSynonyms!]
+    warnUnknownTaxa: Boolean!
+}
+
 type FlatTaxonomy implements Taxonomy {
     name: TaxonomyName!
     taxa: [TaxonName!]!
@@ -2906,6 +3993,30 @@ func (ec *executionContext) _Bookmark_scores(ctx context.Context, field graphql.
 	return ec.marshalOLinkScores2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkScores(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _Bookmark_categorizations(ctx context.Context, field graphql.CollectedField, obj *model.Bookmark) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Bookmark",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Categorizations, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.Categorization)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOCategorization2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐCategorization(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _BookmarkLink_id(ctx context.Context, field graphql.CollectedField, obj *model.BookmarkLink) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
@@ -3011,6 +4122,78 @@ func (ec *executionContext) _BookmarkLink_isValid(ctx context.Context, field gra
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _BookmarkLink_rewrites(ctx context.Context, field graphql.CollectedField, obj *model.BookmarkLink) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "BookmarkLink",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Rewrites, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.URLRewrite)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOURLRewrite2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐURLRewrite(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BookmarkLink_canonicalURL(ctx context.Context, field graphql.CollectedField, obj *model.BookmarkLink) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "BookmarkLink",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CanonicalURL, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.URL)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOURL2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐURL(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BookmarkLink_redirectChain(ctx context.Context, field graphql.CollectedField, obj *model.BookmarkLink) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "BookmarkLink",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RedirectChain, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.LinkRedirect)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOLinkRedirect2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkRedirect(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _Bookmarks_id(ctx context.Context, field graphql.CollectedField, obj *model.Bookmarks) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
@@ -3140,6 +4323,57 @@ func (ec *executionContext) _Bookmarks_properties(ctx context.Context, field gra
 	return ec.marshalOProperties2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐProperties(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _Bookmarks_sourceLinks(ctx context.Context, field graphql.CollectedField, obj *model.Bookmarks) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Bookmarks",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SourceLinks, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.URLText)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOURLText2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Bookmarks_sourceLinksComplete(ctx context.Context, field graphql.CollectedField, obj *model.Bookmarks) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Bookmarks",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SourceLinksComplete, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _BookmarksAPISource_name(ctx context.Context, field graphql.CollectedField, obj *model.BookmarksAPISource) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
@@ -3326,11 +4560,11 @@ func (ec *executionContext) _BookmarksToMarkdownPipelineExecution_activities(ctx
 	return ec.marshalNActivities2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivities(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentBodySettings_allowFrontmatter(ctx context.Context, field graphql.CollectedField, obj *model.ContentBodySettings) graphql.Marshaler {
+func (ec *executionContext) _Categorization_taxonomy(ctx context.Context, field graphql.CollectedField, obj *model.Categorization) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentBodySettings",
+		Object:   "Categorization",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3339,7 +4573,7 @@ func (ec *executionContext) _ContentBodySettings_allowFrontmatter(ctx context.Co
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AllowFrontmatter, nil
+		return obj.Taxonomy, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3347,17 +4581,17 @@ func (ec *executionContext) _ContentBodySettings_allowFrontmatter(ctx context.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.TaxonomyName)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNTaxonomyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonomyName(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentBodySettings_frontMatterPropertyNamePrefix(ctx context.Context, field graphql.CollectedField, obj *model.ContentBodySettings) graphql.Marshaler {
+func (ec *executionContext) _Categorization_taxon(ctx context.Context, field graphql.CollectedField, obj *model.Categorization) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentBodySettings",
+		Object:   "Categorization",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3366,7 +4600,7 @@ func (ec *executionContext) _ContentBodySettings_frontMatterPropertyNamePrefix(c
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FrontMatterPropertyNamePrefix, nil
+		return obj.Taxon, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3374,17 +4608,17 @@ func (ec *executionContext) _ContentBodySettings_frontMatterPropertyNamePrefix(c
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.TaxonName)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTaxonName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonName(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentEditActivity_id(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
+func (ec *executionContext) _Categorization_rule(ctx context.Context, field graphql.CollectedField, obj *model.Categorization) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentEditActivity",
+		Object:   "Categorization",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3393,7 +4627,7 @@ func (ec *executionContext) _ContentEditActivity_id(ctx context.Context, field g
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Rule, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3401,17 +4635,17 @@ func (ec *executionContext) _ContentEditActivity_id(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.NameText)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNNameText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐNameText(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentEditActivity_context(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
+func (ec *executionContext) _ContentBodySettings_allowFrontmatter(ctx context.Context, field graphql.CollectedField, obj *model.ContentBodySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentEditActivity",
+		Object:   "ContentBodySettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3420,7 +4654,7 @@ func (ec *executionContext) _ContentEditActivity_context(ctx context.Context, fi
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Context, nil
+		return obj.AllowFrontmatter, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3428,17 +4662,17 @@ func (ec *executionContext) _ContentEditActivity_context(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ActivityContext)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNActivityContext2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityContext(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentEditActivity_code(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
+func (ec *executionContext) _ContentBodySettings_frontMatterPropertyNamePrefix(ctx context.Context, field graphql.CollectedField, obj *model.ContentBodySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentEditActivity",
+		Object:   "ContentBodySettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3447,7 +4681,7 @@ func (ec *executionContext) _ContentEditActivity_code(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return obj.FrontMatterPropertyNamePrefix, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3455,17 +4689,17 @@ func (ec *executionContext) _ContentEditActivity_code(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ActivityCode)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNActivityCode2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityCode(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentEditActivity_name(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
+func (ec *executionContext) _ContentBodySettings_extractPolicy(ctx context.Context, field graphql.CollectedField, obj *model.ContentBodySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentEditActivity",
+		Object:   "ContentBodySettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3474,7 +4708,7 @@ func (ec *executionContext) _ContentEditActivity_name(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ExtractPolicy, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3482,17 +4716,17 @@ func (ec *executionContext) _ContentEditActivity_name(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ActivityMachineMessage)
+	res := resTmp.(model.ContentBodyExtractPolicy)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNActivityMachineMessage2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityMachineMessage(ctx, field.Selections, res)
+	return ec.marshalNContentBodyExtractPolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentBodyExtractPolicy(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentEditActivity_message(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
+func (ec *executionContext) _ContentBodySettings_extractMinLength(ctx context.Context, field graphql.CollectedField, obj *model.ContentBodySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentEditActivity",
+		Object:   "ContentBodySettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3501,7 +4735,7 @@ func (ec *executionContext) _ContentEditActivity_message(ctx context.Context, fi
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.ExtractMinLength, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3509,17 +4743,17 @@ func (ec *executionContext) _ContentEditActivity_message(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ActivityHumanMessage)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNActivityHumanMessage2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityHumanMessage(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentEditActivity_properties(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
+func (ec *executionContext) _ContentCategorizationRule_name(ctx context.Context, field graphql.CollectedField, obj *model.ContentCategorizationRule) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentEditActivity",
+		Object:   "ContentCategorizationRule",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3528,22 +4762,25 @@ func (ec *executionContext) _ContentEditActivity_properties(ctx context.Context,
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Properties, nil
+		return obj.Name, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.Property)
+	res := resTmp.(model.NameText)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOProperty2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐProperty(ctx, field.Selections, res)
+	return ec.marshalNNameText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐNameText(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentEditActivity_original(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
+func (ec *executionContext) _ContentCategorizationRule_domains(ctx context.Context, field graphql.CollectedField, obj *model.ContentCategorizationRule) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentEditActivity",
+		Object:   "ContentCategorizationRule",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3552,25 +4789,22 @@ func (ec *executionContext) _ContentEditActivity_original(ctx context.Context, f
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Original, nil
+		return obj.Domains, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentEditActivity_modified(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
+func (ec *executionContext) _ContentCategorizationRule_urlRegExpr(ctx context.Context, field graphql.CollectedField, obj *model.ContentCategorizationRule) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentEditActivity",
+		Object:   "ContentCategorizationRule",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3579,25 +4813,22 @@ func (ec *executionContext) _ContentEditActivity_modified(ctx context.Context, f
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Modified, nil
+		return obj.URLRegExpr, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.RegularExpression)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalORegularExpression2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐRegularExpression(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentSettings_store(ctx context.Context, field graphql.CollectedField, obj *model.ContentSettings) graphql.Marshaler {
+func (ec *executionContext) _ContentCategorizationRule_titleKeywords(ctx context.Context, field graphql.CollectedField, obj *model.ContentCategorizationRule) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentSettings",
+		Object:   "ContentCategorizationRule",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3606,25 +4837,22 @@ func (ec *executionContext) _ContentSettings_store(ctx context.Context, field gr
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Store, nil
+		return obj.TitleKeywords, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.SettingsStore)
+	res := resTmp.([]string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNSettingsStore2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSettingsStore(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentSettings_title(ctx context.Context, field graphql.CollectedField, obj *model.ContentSettings) graphql.Marshaler {
+func (ec *executionContext) _ContentCategorizationRule_bodyKeywords(ctx context.Context, field graphql.CollectedField, obj *model.ContentCategorizationRule) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentSettings",
+		Object:   "ContentCategorizationRule",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3633,25 +4861,22 @@ func (ec *executionContext) _ContentSettings_title(ctx context.Context, field gr
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return obj.BodyKeywords, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ContentTitleSettings)
+	res := resTmp.([]string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNContentTitleSettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentTitleSettings(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentSettings_summary(ctx context.Context, field graphql.CollectedField, obj *model.ContentSettings) graphql.Marshaler {
+func (ec *executionContext) _ContentCategorizationRule_propertyName(ctx context.Context, field graphql.CollectedField, obj *model.ContentCategorizationRule) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentSettings",
+		Object:   "ContentCategorizationRule",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3660,25 +4885,22 @@ func (ec *executionContext) _ContentSettings_summary(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Summary, nil
+		return obj.PropertyName, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ContentSummarySettings)
+	res := resTmp.(*model.PropertyName)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNContentSummarySettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentSummarySettings(ctx, field.Selections, res)
+	return ec.marshalOPropertyName2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐPropertyName(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentSettings_body(ctx context.Context, field graphql.CollectedField, obj *model.ContentSettings) graphql.Marshaler {
+func (ec *executionContext) _ContentCategorizationRule_propertyValueRegExpr(ctx context.Context, field graphql.CollectedField, obj *model.ContentCategorizationRule) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentSettings",
+		Object:   "ContentCategorizationRule",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3687,25 +4909,22 @@ func (ec *executionContext) _ContentSettings_body(ctx context.Context, field gra
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Body, nil
+		return obj.PropertyValueRegExpr, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ContentBodySettings)
+	res := resTmp.(*model.RegularExpression)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNContentBodySettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentBodySettings(ctx, field.Selections, res)
+	return ec.marshalORegularExpression2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐRegularExpression(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentSummarySettings_policy(ctx context.Context, field graphql.CollectedField, obj *model.ContentSummarySettings) graphql.Marshaler {
+func (ec *executionContext) _ContentCategorizationRule_taxonomy(ctx context.Context, field graphql.CollectedField, obj *model.ContentCategorizationRule) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentSummarySettings",
+		Object:   "ContentCategorizationRule",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3714,7 +4933,7 @@ func (ec *executionContext) _ContentSummarySettings_policy(ctx context.Context,
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Policy, nil
+		return obj.Taxonomy, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3722,17 +4941,17 @@ func (ec *executionContext) _ContentSummarySettings_policy(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ContentSummaryPolicy)
+	res := resTmp.(model.TaxonomyName)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNContentSummaryPolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentSummaryPolicy(ctx, field.Selections, res)
+	return ec.marshalNTaxonomyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonomyName(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentTitleSettings_pipedSuffixPolicy(ctx context.Context, field graphql.CollectedField, obj *model.ContentTitleSettings) graphql.Marshaler {
+func (ec *executionContext) _ContentCategorizationRule_taxa(ctx context.Context, field graphql.CollectedField, obj *model.ContentCategorizationRule) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentTitleSettings",
+		Object:   "ContentCategorizationRule",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3741,7 +4960,7 @@ func (ec *executionContext) _ContentTitleSettings_pipedSuffixPolicy(ctx context.
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PipedSuffixPolicy, nil
+		return obj.Taxa, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3749,17 +4968,17 @@ func (ec *executionContext) _ContentTitleSettings_pipedSuffixPolicy(ctx context.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ContentTitleSuffixPolicy)
+	res := resTmp.([]model.TaxonName)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNContentTitleSuffixPolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentTitleSuffixPolicy(ctx, field.Selections, res)
+	return ec.marshalNTaxonName2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonName(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContentTitleSettings_hyphenatedSuffixPolicy(ctx context.Context, field graphql.CollectedField, obj *model.ContentTitleSettings) graphql.Marshaler {
+func (ec *executionContext) _ContentEditActivity_id(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ContentTitleSettings",
+		Object:   "ContentEditActivity",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3768,7 +4987,7 @@ func (ec *executionContext) _ContentTitleSettings_hyphenatedSuffixPolicy(ctx con
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HyphenatedSuffixPolicy, nil
+		return obj.ID, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3776,17 +4995,17 @@ func (ec *executionContext) _ContentTitleSettings_hyphenatedSuffixPolicy(ctx con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ContentTitleSuffixPolicy)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNContentTitleSuffixPolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentTitleSuffixPolicy(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DateTimeProperty_name(ctx context.Context, field graphql.CollectedField, obj *model.DateTimeProperty) graphql.Marshaler {
+func (ec *executionContext) _ContentEditActivity_context(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "DateTimeProperty",
+		Object:   "ContentEditActivity",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3795,7 +5014,7 @@ func (ec *executionContext) _DateTimeProperty_name(ctx context.Context, field gr
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Context, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3803,17 +5022,17 @@ func (ec *executionContext) _DateTimeProperty_name(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.PropertyName)
+	res := resTmp.(model.ActivityContext)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNPropertyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐPropertyName(ctx, field.Selections, res)
+	return ec.marshalNActivityContext2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityContext(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DateTimeProperty_value(ctx context.Context, field graphql.CollectedField, obj *model.DateTimeProperty) graphql.Marshaler {
+func (ec *executionContext) _ContentEditActivity_code(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "DateTimeProperty",
+		Object:   "ContentEditActivity",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3822,7 +5041,7 @@ func (ec *executionContext) _DateTimeProperty_value(ctx context.Context, field g
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Code, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3830,17 +5049,17 @@ func (ec *executionContext) _DateTimeProperty_value(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.DateTime)
+	res := resTmp.(model.ActivityCode)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNDateTime2githubᚗcomᚋlectioᚋgraphᚋmodelᚐDateTime(ctx, field.Selections, res)
+	return ec.marshalNActivityCode2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityCode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FacebookLinkScorer_machineName(ctx context.Context, field graphql.CollectedField, obj *model.FacebookLinkScorer) graphql.Marshaler {
+func (ec *executionContext) _ContentEditActivity_name(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "FacebookLinkScorer",
+		Object:   "ContentEditActivity",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3849,7 +5068,7 @@ func (ec *executionContext) _FacebookLinkScorer_machineName(ctx context.Context,
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MachineName, nil
+		return obj.Name, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3857,17 +5076,17 @@ func (ec *executionContext) _FacebookLinkScorer_machineName(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.ActivityMachineMessage)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNLinkScorerMachineName2string(ctx, field.Selections, res)
+	return ec.marshalNActivityMachineMessage2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityMachineMessage(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FacebookLinkScorer_humanName(ctx context.Context, field graphql.CollectedField, obj *model.FacebookLinkScorer) graphql.Marshaler {
+func (ec *executionContext) _ContentEditActivity_message(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "FacebookLinkScorer",
+		Object:   "ContentEditActivity",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3876,7 +5095,7 @@ func (ec *executionContext) _FacebookLinkScorer_humanName(ctx context.Context, f
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HumanName, nil
+		return obj.Message, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3884,17 +5103,17 @@ func (ec *executionContext) _FacebookLinkScorer_humanName(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.ActivityHumanMessage)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNLinkScorerHumanName2string(ctx, field.Selections, res)
+	return ec.marshalNActivityHumanMessage2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityHumanMessage(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FacebookLinkScores_scorer(ctx context.Context, field graphql.CollectedField, obj *model.FacebookLinkScores) graphql.Marshaler {
+func (ec *executionContext) _ContentEditActivity_properties(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "FacebookLinkScores",
+		Object:   "ContentEditActivity",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3903,25 +5122,22 @@ func (ec *executionContext) _FacebookLinkScores_scorer(ctx context.Context, fiel
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Scorer, nil
+		return obj.Properties, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.LinkScorer)
+	res := resTmp.([]model.Property)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNLinkScorer2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkScorer(ctx, field.Selections, res)
+	return ec.marshalOProperty2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐProperty(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FacebookLinkScores_isValid(ctx context.Context, field graphql.CollectedField, obj *model.FacebookLinkScores) graphql.Marshaler {
+func (ec *executionContext) _ContentEditActivity_original(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "FacebookLinkScores",
+		Object:   "ContentEditActivity",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3930,7 +5146,7 @@ func (ec *executionContext) _FacebookLinkScores_isValid(ctx context.Context, fie
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsValid, nil
+		return obj.Original, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3938,17 +5154,17 @@ func (ec *executionContext) _FacebookLinkScores_isValid(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FacebookLinkScores_targetURL(ctx context.Context, field graphql.CollectedField, obj *model.FacebookLinkScores) graphql.Marshaler {
+func (ec *executionContext) _ContentEditActivity_modified(ctx context.Context, field graphql.CollectedField, obj *model.ContentEditActivity) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "FacebookLinkScores",
+		Object:   "ContentEditActivity",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3957,7 +5173,7 @@ func (ec *executionContext) _FacebookLinkScores_targetURL(ctx context.Context, f
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TargetURL, nil
+		return obj.Modified, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3965,17 +5181,17 @@ func (ec *executionContext) _FacebookLinkScores_targetURL(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.URLText)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNURLText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FacebookLinkScores_sharesCount(ctx context.Context, field graphql.CollectedField, obj *model.FacebookLinkScores) graphql.Marshaler {
+func (ec *executionContext) _ContentKeywordsSettings_extractKeywords(ctx context.Context, field graphql.CollectedField, obj *model.ContentKeywordsSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "FacebookLinkScores",
+		Object:   "ContentKeywordsSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3984,7 +5200,7 @@ func (ec *executionContext) _FacebookLinkScores_sharesCount(ctx context.Context,
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SharesCount, nil
+		return obj.ExtractKeywords, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -3992,17 +5208,17 @@ func (ec *executionContext) _FacebookLinkScores_sharesCount(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FacebookLinkScores_commentsCount(ctx context.Context, field graphql.CollectedField, obj *model.FacebookLinkScores) graphql.Marshaler {
+func (ec *executionContext) _ContentKeywordsSettings_keywordsTaxonomy(ctx context.Context, field graphql.CollectedField, obj *model.ContentKeywordsSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "FacebookLinkScores",
+		Object:   "ContentKeywordsSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4011,7 +5227,7 @@ func (ec *executionContext) _FacebookLinkScores_commentsCount(ctx context.Contex
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CommentsCount, nil
+		return obj.KeywordsTaxonomy, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4019,17 +5235,17 @@ func (ec *executionContext) _FacebookLinkScores_commentsCount(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(model.TaxonomyName)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTaxonomyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonomyName(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FileRepository_name(ctx context.Context, field graphql.CollectedField, obj *model.FileRepository) graphql.Marshaler {
+func (ec *executionContext) _ContentKeywordsSettings_extractEntities(ctx context.Context, field graphql.CollectedField, obj *model.ContentKeywordsSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "FileRepository",
+		Object:   "ContentKeywordsSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4038,7 +5254,7 @@ func (ec *executionContext) _FileRepository_name(ctx context.Context, field grap
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ExtractEntities, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4046,17 +5262,17 @@ func (ec *executionContext) _FileRepository_name(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.RepositoryName)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNRepositoryName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐRepositoryName(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FileRepository_url(ctx context.Context, field graphql.CollectedField, obj *model.FileRepository) graphql.Marshaler {
+func (ec *executionContext) _ContentKeywordsSettings_entitiesTaxonomy(ctx context.Context, field graphql.CollectedField, obj *model.ContentKeywordsSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "FileRepository",
+		Object:   "ContentKeywordsSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4065,7 +5281,7 @@ func (ec *executionContext) _FileRepository_url(ctx context.Context, field graph
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URL, nil
+		return obj.EntitiesTaxonomy, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4073,17 +5289,17 @@ func (ec *executionContext) _FileRepository_url(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.URLText)
+	res := resTmp.(model.TaxonomyName)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNRepositoryURL2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
+	return ec.marshalNTaxonomyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonomyName(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FileRepository_rootPath(ctx context.Context, field graphql.CollectedField, obj *model.FileRepository) graphql.Marshaler {
+func (ec *executionContext) _ContentKeywordsSettings_maxTerms(ctx context.Context, field graphql.CollectedField, obj *model.ContentKeywordsSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "FileRepository",
+		Object:   "ContentKeywordsSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4092,7 +5308,7 @@ func (ec *executionContext) _FileRepository_rootPath(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RootPath, nil
+		return obj.MaxTerms, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4100,17 +5316,17 @@ func (ec *executionContext) _FileRepository_rootPath(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNFileRepositoryPath2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FileRepository_createRootPath(ctx context.Context, field graphql.CollectedField, obj *model.FileRepository) graphql.Marshaler {
+func (ec *executionContext) _ContentKeywordsSettings_minFrequency(ctx context.Context, field graphql.CollectedField, obj *model.ContentKeywordsSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "FileRepository",
+		Object:   "ContentKeywordsSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4119,7 +5335,7 @@ func (ec *executionContext) _FileRepository_createRootPath(ctx context.Context,
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreateRootPath, nil
+		return obj.MinFrequency, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4127,17 +5343,17 @@ func (ec *executionContext) _FileRepository_createRootPath(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FlagProperty_name(ctx context.Context, field graphql.CollectedField, obj *model.FlagProperty) graphql.Marshaler {
+func (ec *executionContext) _ContentKeywordsSettings_stopWords(ctx context.Context, field graphql.CollectedField, obj *model.ContentKeywordsSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "FlagProperty",
+		Object:   "ContentKeywordsSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4146,25 +5362,22 @@ func (ec *executionContext) _FlagProperty_name(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.StopWords, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.PropertyName)
+	res := resTmp.([]string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNPropertyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐPropertyName(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FlagProperty_value(ctx context.Context, field graphql.CollectedField, obj *model.FlagProperty) graphql.Marshaler {
+func (ec *executionContext) _ContentMetadataSettings_fillMissingTitle(ctx context.Context, field graphql.CollectedField, obj *model.ContentMetadataSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "FlagProperty",
+		Object:   "ContentMetadataSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4173,7 +5386,7 @@ func (ec *executionContext) _FlagProperty_value(ctx context.Context, field graph
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.FillMissingTitle, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4187,11 +5400,11 @@ func (ec *executionContext) _FlagProperty_value(ctx context.Context, field graph
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FlatTaxonomy_name(ctx context.Context, field graphql.CollectedField, obj *model.FlatTaxonomy) graphql.Marshaler {
+func (ec *executionContext) _ContentMetadataSettings_fillMissingSummary(ctx context.Context, field graphql.CollectedField, obj *model.ContentMetadataSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "FlatTaxonomy",
+		Object:   "ContentMetadataSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4200,7 +5413,7 @@ func (ec *executionContext) _FlatTaxonomy_name(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.FillMissingSummary, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4208,17 +5421,17 @@ func (ec *executionContext) _FlatTaxonomy_name(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.TaxonomyName)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTaxonomyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonomyName(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FlatTaxonomy_taxa(ctx context.Context, field graphql.CollectedField, obj *model.FlatTaxonomy) graphql.Marshaler {
+func (ec *executionContext) _ContentMetadataSettings_fillMissingDate(ctx context.Context, field graphql.CollectedField, obj *model.ContentMetadataSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "FlatTaxonomy",
+		Object:   "ContentMetadataSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4227,7 +5440,7 @@ func (ec *executionContext) _FlatTaxonomy_taxa(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Taxa, nil
+		return obj.FillMissingDate, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4235,17 +5448,17 @@ func (ec *executionContext) _FlatTaxonomy_taxa(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.TaxonName)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTaxonName2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonName(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _GitHubRepository_name(ctx context.Context, field graphql.CollectedField, obj *model.GitHubRepository) graphql.Marshaler {
+func (ec *executionContext) _ContentMetadataSettings_fillMissingFeaturedImage(ctx context.Context, field graphql.CollectedField, obj *model.ContentMetadataSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "GitHubRepository",
+		Object:   "ContentMetadataSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4254,7 +5467,7 @@ func (ec *executionContext) _GitHubRepository_name(ctx context.Context, field gr
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.FillMissingFeaturedImage, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4262,17 +5475,17 @@ func (ec *executionContext) _GitHubRepository_name(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.RepositoryName)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNRepositoryName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐRepositoryName(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _GitHubRepository_url(ctx context.Context, field graphql.CollectedField, obj *model.GitHubRepository) graphql.Marshaler {
+func (ec *executionContext) _ContentSettings_store(ctx context.Context, field graphql.CollectedField, obj *model.ContentSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "GitHubRepository",
+		Object:   "ContentSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4281,7 +5494,7 @@ func (ec *executionContext) _GitHubRepository_url(ctx context.Context, field gra
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URL, nil
+		return obj.Store, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4289,17 +5502,17 @@ func (ec *executionContext) _GitHubRepository_url(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.URLText)
+	res := resTmp.(model.SettingsStore)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNRepositoryURL2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
+	return ec.marshalNSettingsStore2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSettingsStore(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _GitHubRepository_token(ctx context.Context, field graphql.CollectedField, obj *model.GitHubRepository) graphql.Marshaler {
+func (ec *executionContext) _ContentSettings_title(ctx context.Context, field graphql.CollectedField, obj *model.ContentSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "GitHubRepository",
+		Object:   "ContentSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4308,7 +5521,7 @@ func (ec *executionContext) _GitHubRepository_token(ctx context.Context, field g
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Token, nil
+		return obj.Title, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4316,17 +5529,17 @@ func (ec *executionContext) _GitHubRepository_token(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.SecretText)
+	res := resTmp.(model.ContentTitleSettings)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNSecretText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSecretText(ctx, field.Selections, res)
+	return ec.marshalNContentTitleSettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentTitleSettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HTTPClientSettings_store(ctx context.Context, field graphql.CollectedField, obj *model.HTTPClientSettings) graphql.Marshaler {
+func (ec *executionContext) _ContentSettings_summary(ctx context.Context, field graphql.CollectedField, obj *model.ContentSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "HTTPClientSettings",
+		Object:   "ContentSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4335,7 +5548,7 @@ func (ec *executionContext) _HTTPClientSettings_store(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Store, nil
+		return obj.Summary, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4343,17 +5556,17 @@ func (ec *executionContext) _HTTPClientSettings_store(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.SettingsStore)
+	res := resTmp.(model.ContentSummarySettings)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNSettingsStore2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSettingsStore(ctx, field.Selections, res)
+	return ec.marshalNContentSummarySettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentSummarySettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HTTPClientSettings_userAgent(ctx context.Context, field graphql.CollectedField, obj *model.HTTPClientSettings) graphql.Marshaler {
+func (ec *executionContext) _ContentSettings_body(ctx context.Context, field graphql.CollectedField, obj *model.ContentSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "HTTPClientSettings",
+		Object:   "ContentSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4362,7 +5575,7 @@ func (ec *executionContext) _HTTPClientSettings_userAgent(ctx context.Context, f
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UserAgent, nil
+		return obj.Body, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4370,17 +5583,17 @@ func (ec *executionContext) _HTTPClientSettings_userAgent(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.ContentBodySettings)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNContentBodySettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentBodySettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HTTPClientSettings_timeout(ctx context.Context, field graphql.CollectedField, obj *model.HTTPClientSettings) graphql.Marshaler {
+func (ec *executionContext) _ContentSettings_metadata(ctx context.Context, field graphql.CollectedField, obj *model.ContentSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "HTTPClientSettings",
+		Object:   "ContentSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4389,7 +5602,7 @@ func (ec *executionContext) _HTTPClientSettings_timeout(ctx context.Context, fie
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Timeout, nil
+		return obj.Metadata, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4397,17 +5610,17 @@ func (ec *executionContext) _HTTPClientSettings_timeout(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.TimeoutDuration)
+	res := resTmp.(model.ContentMetadataSettings)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNHTTPClientTimeoutDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx, field.Selections, res)
+	return ec.marshalNContentMetadataSettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentMetadataSettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HTTPClientSettings_cache(ctx context.Context, field graphql.CollectedField, obj *model.HTTPClientSettings) graphql.Marshaler {
+func (ec *executionContext) _ContentSettings_taxonomies(ctx context.Context, field graphql.CollectedField, obj *model.ContentSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "HTTPClientSettings",
+		Object:   "ContentSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4416,7 +5629,7 @@ func (ec *executionContext) _HTTPClientSettings_cache(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cache, nil
+		return obj.Taxonomies, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4424,17 +5637,17 @@ func (ec *executionContext) _HTTPClientSettings_cache(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.HTTPCache)
+	res := resTmp.(model.ContentTaxonomySettings)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNHTTPCache2githubᚗcomᚋlectioᚋgraphᚋmodelᚐHTTPCache(ctx, field.Selections, res)
+	return ec.marshalNContentTaxonomySettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentTaxonomySettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HTTPDiskCache_name(ctx context.Context, field graphql.CollectedField, obj *model.HTTPDiskCache) graphql.Marshaler {
+func (ec *executionContext) _ContentSettings_keywords(ctx context.Context, field graphql.CollectedField, obj *model.ContentSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "HTTPDiskCache",
+		Object:   "ContentSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4443,7 +5656,7 @@ func (ec *executionContext) _HTTPDiskCache_name(ctx context.Context, field graph
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Keywords, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4451,17 +5664,17 @@ func (ec *executionContext) _HTTPDiskCache_name(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.ContentKeywordsSettings)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNHTTPCacheName2string(ctx, field.Selections, res)
+	return ec.marshalNContentKeywordsSettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentKeywordsSettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HTTPDiskCache_activities(ctx context.Context, field graphql.CollectedField, obj *model.HTTPDiskCache) graphql.Marshaler {
+func (ec *executionContext) _ContentSettings_categorizationRules(ctx context.Context, field graphql.CollectedField, obj *model.ContentSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "HTTPDiskCache",
+		Object:   "ContentSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4470,25 +5683,22 @@ func (ec *executionContext) _HTTPDiskCache_activities(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Activities, nil
+		return obj.CategorizationRules, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Activities)
+	res := resTmp.([]model.ContentCategorizationRule)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNActivities2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivities(ctx, field.Selections, res)
+	return ec.marshalOContentCategorizationRule2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐContentCategorizationRule(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HTTPDiskCache_basePath(ctx context.Context, field graphql.CollectedField, obj *model.HTTPDiskCache) graphql.Marshaler {
+func (ec *executionContext) _ContentSummarySettings_policy(ctx context.Context, field graphql.CollectedField, obj *model.ContentSummarySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "HTTPDiskCache",
+		Object:   "ContentSummarySettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4497,7 +5707,7 @@ func (ec *executionContext) _HTTPDiskCache_basePath(ctx context.Context, field g
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BasePath, nil
+		return obj.Policy, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4505,17 +5715,17 @@ func (ec *executionContext) _HTTPDiskCache_basePath(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.ContentSummaryPolicy)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNRelativeDirectoryPath2string(ctx, field.Selections, res)
+	return ec.marshalNContentSummaryPolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentSummaryPolicy(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HTTPDiskCache_createBasePath(ctx context.Context, field graphql.CollectedField, obj *model.HTTPDiskCache) graphql.Marshaler {
+func (ec *executionContext) _ContentSummarySettings_sentenceSplitter(ctx context.Context, field graphql.CollectedField, obj *model.ContentSummarySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "HTTPDiskCache",
+		Object:   "ContentSummarySettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4524,7 +5734,7 @@ func (ec *executionContext) _HTTPDiskCache_createBasePath(ctx context.Context, f
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreateBasePath, nil
+		return obj.SentenceSplitter, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4532,17 +5742,17 @@ func (ec *executionContext) _HTTPDiskCache_createBasePath(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.ContentSentenceSplitter)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNContentSentenceSplitter2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentSentenceSplitter(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HTTPMemoryCache_name(ctx context.Context, field graphql.CollectedField, obj *model.HTTPMemoryCache) graphql.Marshaler {
+func (ec *executionContext) _ContentSummarySettings_extractiveSentences(ctx context.Context, field graphql.CollectedField, obj *model.ContentSummarySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "HTTPMemoryCache",
+		Object:   "ContentSummarySettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4551,7 +5761,7 @@ func (ec *executionContext) _HTTPMemoryCache_name(ctx context.Context, field gra
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ExtractiveSentences, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4559,17 +5769,17 @@ func (ec *executionContext) _HTTPMemoryCache_name(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNHTTPCacheName2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HTTPMemoryCache_activities(ctx context.Context, field graphql.CollectedField, obj *model.HTTPMemoryCache) graphql.Marshaler {
+func (ec *executionContext) _ContentSummarySettings_extractiveMaxLength(ctx context.Context, field graphql.CollectedField, obj *model.ContentSummarySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "HTTPMemoryCache",
+		Object:   "ContentSummarySettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4578,7 +5788,7 @@ func (ec *executionContext) _HTTPMemoryCache_activities(ctx context.Context, fie
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Activities, nil
+		return obj.ExtractiveMaxLength, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4586,17 +5796,17 @@ func (ec *executionContext) _HTTPMemoryCache_activities(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Activities)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNActivities2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivities(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HiearchicalTaxonomy_name(ctx context.Context, field graphql.CollectedField, obj *model.HiearchicalTaxonomy) graphql.Marshaler {
+func (ec *executionContext) _ContentSummarySettings_fallbackToMetaDescription(ctx context.Context, field graphql.CollectedField, obj *model.ContentSummarySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "HiearchicalTaxonomy",
+		Object:   "ContentSummarySettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4605,7 +5815,7 @@ func (ec *executionContext) _HiearchicalTaxonomy_name(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.FallbackToMetaDescription, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4613,17 +5823,17 @@ func (ec *executionContext) _HiearchicalTaxonomy_name(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.TaxonomyName)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTaxonomyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonomyName(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HiearchicalTaxonomy_taxa(ctx context.Context, field graphql.CollectedField, obj *model.HiearchicalTaxonomy) graphql.Marshaler {
+func (ec *executionContext) _ContentTaxonomySettings_tagPathDelimiter(ctx context.Context, field graphql.CollectedField, obj *model.ContentTaxonomySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "HiearchicalTaxonomy",
+		Object:   "ContentTaxonomySettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4632,7 +5842,7 @@ func (ec *executionContext) _HiearchicalTaxonomy_taxa(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Taxa, nil
+		return obj.TagPathDelimiter, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4640,17 +5850,17 @@ func (ec *executionContext) _HiearchicalTaxonomy_taxa(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.TaxonNode)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTaxonNode2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonNode(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkLifecyleSettings_store(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
+func (ec *executionContext) _ContentTaxonomySettings_normalizations(ctx context.Context, field graphql.CollectedField, obj *model.ContentTaxonomySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkLifecyleSettings",
+		Object:   "ContentTaxonomySettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4659,25 +5869,22 @@ func (ec *executionContext) _LinkLifecyleSettings_store(ctx context.Context, fie
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Store, nil
+		return obj.Normalizations, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.SettingsStore)
+	res := resTmp.([]model.TaxonomyNormalization)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNSettingsStore2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSettingsStore(ctx, field.Selections, res)
+	return ec.marshalOTaxonomyNormalization2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonomyNormalization(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkLifecyleSettings_traverseLinks(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
+func (ec *executionContext) _ContentTitleSettings_pipedSuffixPolicy(ctx context.Context, field graphql.CollectedField, obj *model.ContentTitleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkLifecyleSettings",
+		Object:   "ContentTitleSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4686,7 +5893,7 @@ func (ec *executionContext) _LinkLifecyleSettings_traverseLinks(ctx context.Cont
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TraverseLinks, nil
+		return obj.PipedSuffixPolicy, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4694,17 +5901,17 @@ func (ec *executionContext) _LinkLifecyleSettings_traverseLinks(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.ContentTitleSuffixPolicy)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNContentTitleSuffixPolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentTitleSuffixPolicy(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkLifecyleSettings_scoreLinks(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
+func (ec *executionContext) _ContentTitleSettings_hyphenatedSuffixPolicy(ctx context.Context, field graphql.CollectedField, obj *model.ContentTitleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkLifecyleSettings",
+		Object:   "ContentTitleSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4713,7 +5920,7 @@ func (ec *executionContext) _LinkLifecyleSettings_scoreLinks(ctx context.Context
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ScoreLinks, nil
+		return obj.HyphenatedSuffixPolicy, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4721,17 +5928,17 @@ func (ec *executionContext) _LinkLifecyleSettings_scoreLinks(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.LinkScoresLifecycleSettings)
+	res := resTmp.(model.ContentTitleSuffixPolicy)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNLinkScoresLifecycleSettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkScoresLifecycleSettings(ctx, field.Selections, res)
+	return ec.marshalNContentTitleSuffixPolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentTitleSuffixPolicy(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkLifecyleSettings_ignoreURLsRegExprs(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
+func (ec *executionContext) _DateTimeProperty_name(ctx context.Context, field graphql.CollectedField, obj *model.DateTimeProperty) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkLifecyleSettings",
+		Object:   "DateTimeProperty",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4740,22 +5947,25 @@ func (ec *executionContext) _LinkLifecyleSettings_ignoreURLsRegExprs(ctx context
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IgnoreURLsRegExprs, nil
+		return obj.Name, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.RegularExpression)
+	res := resTmp.(model.PropertyName)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalORegularExpression2ᚕᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐRegularExpression(ctx, field.Selections, res)
+	return ec.marshalNPropertyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐPropertyName(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkLifecyleSettings_removeParamsFromURLsRegEx(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
+func (ec *executionContext) _DateTimeProperty_value(ctx context.Context, field graphql.CollectedField, obj *model.DateTimeProperty) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkLifecyleSettings",
+		Object:   "DateTimeProperty",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4764,22 +5974,25 @@ func (ec *executionContext) _LinkLifecyleSettings_removeParamsFromURLsRegEx(ctx
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RemoveParamsFromURLsRegEx, nil
+		return obj.Value, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.RegularExpression)
+	res := resTmp.(model.DateTime)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalORegularExpression2ᚕᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐRegularExpression(ctx, field.Selections, res)
+	return ec.marshalNDateTime2githubᚗcomᚋlectioᚋgraphᚋmodelᚐDateTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkLifecyleSettings_followRedirectsInLinkDestinationHTMLContent(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
+func (ec *executionContext) _FacebookLinkScorer_machineName(ctx context.Context, field graphql.CollectedField, obj *model.FacebookLinkScorer) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkLifecyleSettings",
+		Object:   "FacebookLinkScorer",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4788,7 +6001,7 @@ func (ec *executionContext) _LinkLifecyleSettings_followRedirectsInLinkDestinati
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FollowRedirectsInLinkDestinationHTMLContent, nil
+		return obj.MachineName, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4796,17 +6009,17 @@ func (ec *executionContext) _LinkLifecyleSettings_followRedirectsInLinkDestinati
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNLinkScorerMachineName2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkLifecyleSettings_parseMetaDataInLinkDestinationHTMLContent(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
+func (ec *executionContext) _FacebookLinkScorer_humanName(ctx context.Context, field graphql.CollectedField, obj *model.FacebookLinkScorer) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkLifecyleSettings",
+		Object:   "FacebookLinkScorer",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4815,7 +6028,7 @@ func (ec *executionContext) _LinkLifecyleSettings_parseMetaDataInLinkDestination
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ParseMetaDataInLinkDestinationHTMLContent, nil
+		return obj.HumanName, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4823,17 +6036,17 @@ func (ec *executionContext) _LinkLifecyleSettings_parseMetaDataInLinkDestination
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNLinkScorerHumanName2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkLifecyleSettings_downloadLinkDestinationAttachments(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
+func (ec *executionContext) _FacebookLinkScores_scorer(ctx context.Context, field graphql.CollectedField, obj *model.FacebookLinkScores) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkLifecyleSettings",
+		Object:   "FacebookLinkScores",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4842,7 +6055,7 @@ func (ec *executionContext) _LinkLifecyleSettings_downloadLinkDestinationAttachm
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DownloadLinkDestinationAttachments, nil
+		return obj.Scorer, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4850,17 +6063,17 @@ func (ec *executionContext) _LinkLifecyleSettings_downloadLinkDestinationAttachm
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.LinkScorer)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNLinkScorer2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkScorer(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkScoresLifecycleSettings_score(ctx context.Context, field graphql.CollectedField, obj *model.LinkScoresLifecycleSettings) graphql.Marshaler {
+func (ec *executionContext) _FacebookLinkScores_isValid(ctx context.Context, field graphql.CollectedField, obj *model.FacebookLinkScores) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkScoresLifecycleSettings",
+		Object:   "FacebookLinkScores",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4869,7 +6082,7 @@ func (ec *executionContext) _LinkScoresLifecycleSettings_score(ctx context.Conte
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Score, nil
+		return obj.IsValid, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4883,11 +6096,11 @@ func (ec *executionContext) _LinkScoresLifecycleSettings_score(ctx context.Conte
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkScoresLifecycleSettings_simulate(ctx context.Context, field graphql.CollectedField, obj *model.LinkScoresLifecycleSettings) graphql.Marshaler {
+func (ec *executionContext) _FacebookLinkScores_targetURL(ctx context.Context, field graphql.CollectedField, obj *model.FacebookLinkScores) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkScoresLifecycleSettings",
+		Object:   "FacebookLinkScores",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4896,7 +6109,7 @@ func (ec *executionContext) _LinkScoresLifecycleSettings_simulate(ctx context.Co
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Simulate, nil
+		return obj.TargetURL, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4904,17 +6117,17 @@ func (ec *executionContext) _LinkScoresLifecycleSettings_simulate(ctx context.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.URLText)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNURLText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkedInLinkScorer_machineName(ctx context.Context, field graphql.CollectedField, obj *model.LinkedInLinkScorer) graphql.Marshaler {
+func (ec *executionContext) _FacebookLinkScores_sharesCount(ctx context.Context, field graphql.CollectedField, obj *model.FacebookLinkScores) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkedInLinkScorer",
+		Object:   "FacebookLinkScores",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4923,7 +6136,7 @@ func (ec *executionContext) _LinkedInLinkScorer_machineName(ctx context.Context,
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MachineName, nil
+		return obj.SharesCount, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4931,17 +6144,17 @@ func (ec *executionContext) _LinkedInLinkScorer_machineName(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNLinkScorerMachineName2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkedInLinkScorer_humanName(ctx context.Context, field graphql.CollectedField, obj *model.LinkedInLinkScorer) graphql.Marshaler {
+func (ec *executionContext) _FacebookLinkScores_commentsCount(ctx context.Context, field graphql.CollectedField, obj *model.FacebookLinkScores) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkedInLinkScorer",
+		Object:   "FacebookLinkScores",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4950,7 +6163,7 @@ func (ec *executionContext) _LinkedInLinkScorer_humanName(ctx context.Context, f
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HumanName, nil
+		return obj.CommentsCount, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4958,17 +6171,17 @@ func (ec *executionContext) _LinkedInLinkScorer_humanName(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNLinkScorerHumanName2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkedInLinkScores_scorer(ctx context.Context, field graphql.CollectedField, obj *model.LinkedInLinkScores) graphql.Marshaler {
+func (ec *executionContext) _FileRepository_name(ctx context.Context, field graphql.CollectedField, obj *model.FileRepository) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkedInLinkScores",
+		Object:   "FileRepository",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4977,7 +6190,7 @@ func (ec *executionContext) _LinkedInLinkScores_scorer(ctx context.Context, fiel
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Scorer, nil
+		return obj.Name, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -4985,17 +6198,17 @@ func (ec *executionContext) _LinkedInLinkScores_scorer(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.LinkScorer)
+	res := resTmp.(model.RepositoryName)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNLinkScorer2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkScorer(ctx, field.Selections, res)
+	return ec.marshalNRepositoryName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐRepositoryName(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkedInLinkScores_isValid(ctx context.Context, field graphql.CollectedField, obj *model.LinkedInLinkScores) graphql.Marshaler {
+func (ec *executionContext) _FileRepository_url(ctx context.Context, field graphql.CollectedField, obj *model.FileRepository) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkedInLinkScores",
+		Object:   "FileRepository",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5004,7 +6217,7 @@ func (ec *executionContext) _LinkedInLinkScores_isValid(ctx context.Context, fie
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsValid, nil
+		return obj.URL, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5012,17 +6225,17 @@ func (ec *executionContext) _LinkedInLinkScores_isValid(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.URLText)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNRepositoryURL2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkedInLinkScores_targetURL(ctx context.Context, field graphql.CollectedField, obj *model.LinkedInLinkScores) graphql.Marshaler {
+func (ec *executionContext) _FileRepository_rootPath(ctx context.Context, field graphql.CollectedField, obj *model.FileRepository) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkedInLinkScores",
+		Object:   "FileRepository",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5031,7 +6244,7 @@ func (ec *executionContext) _LinkedInLinkScores_targetURL(ctx context.Context, f
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TargetURL, nil
+		return obj.RootPath, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5039,17 +6252,17 @@ func (ec *executionContext) _LinkedInLinkScores_targetURL(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.URLText)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNURLText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
+	return ec.marshalNFileRepositoryPath2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkedInLinkScores_sharesCount(ctx context.Context, field graphql.CollectedField, obj *model.LinkedInLinkScores) graphql.Marshaler {
+func (ec *executionContext) _FileRepository_createRootPath(ctx context.Context, field graphql.CollectedField, obj *model.FileRepository) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkedInLinkScores",
+		Object:   "FileRepository",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5058,7 +6271,7 @@ func (ec *executionContext) _LinkedInLinkScores_sharesCount(ctx context.Context,
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SharesCount, nil
+		return obj.CreateRootPath, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5066,17 +6279,17 @@ func (ec *executionContext) _LinkedInLinkScores_sharesCount(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LinkedInLinkScores_commentsCount(ctx context.Context, field graphql.CollectedField, obj *model.LinkedInLinkScores) graphql.Marshaler {
+func (ec *executionContext) _FlagProperty_name(ctx context.Context, field graphql.CollectedField, obj *model.FlagProperty) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "LinkedInLinkScores",
+		Object:   "FlagProperty",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5085,7 +6298,7 @@ func (ec *executionContext) _LinkedInLinkScores_commentsCount(ctx context.Contex
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CommentsCount, nil
+		return obj.Name, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5093,17 +6306,17 @@ func (ec *executionContext) _LinkedInLinkScores_commentsCount(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(model.PropertyName)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNPropertyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐPropertyName(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MarkdownGeneratorSettings_store(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+func (ec *executionContext) _FlagProperty_value(ctx context.Context, field graphql.CollectedField, obj *model.FlagProperty) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "MarkdownGeneratorSettings",
+		Object:   "FlagProperty",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5112,7 +6325,7 @@ func (ec *executionContext) _MarkdownGeneratorSettings_store(ctx context.Context
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Store, nil
+		return obj.Value, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5120,17 +6333,17 @@ func (ec *executionContext) _MarkdownGeneratorSettings_store(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.SettingsStore)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNSettingsStore2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSettingsStore(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MarkdownGeneratorSettings_cancelOnWriteErrors(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+func (ec *executionContext) _FlatTaxonomy_name(ctx context.Context, field graphql.CollectedField, obj *model.FlatTaxonomy) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "MarkdownGeneratorSettings",
+		Object:   "FlatTaxonomy",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5139,7 +6352,7 @@ func (ec *executionContext) _MarkdownGeneratorSettings_cancelOnWriteErrors(ctx c
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CancelOnWriteErrors, nil
+		return obj.Name, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5147,17 +6360,17 @@ func (ec *executionContext) _MarkdownGeneratorSettings_cancelOnWriteErrors(ctx c
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(model.TaxonomyName)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTaxonomyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonomyName(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MarkdownGeneratorSettings_contentPath(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+func (ec *executionContext) _FlatTaxonomy_taxa(ctx context.Context, field graphql.CollectedField, obj *model.FlatTaxonomy) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "MarkdownGeneratorSettings",
+		Object:   "FlatTaxonomy",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5166,7 +6379,7 @@ func (ec *executionContext) _MarkdownGeneratorSettings_contentPath(ctx context.C
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContentPath, nil
+		return obj.Taxa, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5174,17 +6387,17 @@ func (ec *executionContext) _MarkdownGeneratorSettings_contentPath(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]model.TaxonName)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNRelativeDirectoryPath2string(ctx, field.Selections, res)
+	return ec.marshalNTaxonName2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonName(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MarkdownGeneratorSettings_imagesPath(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+func (ec *executionContext) _GitHubRepository_name(ctx context.Context, field graphql.CollectedField, obj *model.GitHubRepository) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "MarkdownGeneratorSettings",
+		Object:   "GitHubRepository",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5193,7 +6406,7 @@ func (ec *executionContext) _MarkdownGeneratorSettings_imagesPath(ctx context.Co
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ImagesPath, nil
+		return obj.Name, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5201,17 +6414,17 @@ func (ec *executionContext) _MarkdownGeneratorSettings_imagesPath(ctx context.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.RepositoryName)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNRelativeDirectoryPath2string(ctx, field.Selections, res)
+	return ec.marshalNRepositoryName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐRepositoryName(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MarkdownGeneratorSettings_imagesURLRel(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+func (ec *executionContext) _GitHubRepository_url(ctx context.Context, field graphql.CollectedField, obj *model.GitHubRepository) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "MarkdownGeneratorSettings",
+		Object:   "GitHubRepository",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5220,7 +6433,7 @@ func (ec *executionContext) _MarkdownGeneratorSettings_imagesURLRel(ctx context.
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ImagesURLRel, nil
+		return obj.URL, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5231,30 +6444,23 @@ func (ec *executionContext) _MarkdownGeneratorSettings_imagesURLRel(ctx context.
 	res := resTmp.(model.URLText)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNURLText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
+	return ec.marshalNRepositoryURL2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_executePipeline(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _GitHubRepository_token(ctx context.Context, field graphql.CollectedField, obj *model.GitHubRepository) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "Mutation",
+		Object:   "GitHubRepository",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_executePipeline_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().ExecutePipeline(rctx, args["input"].(model.ExecutePipelineInput))
+		return obj.Token, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5262,33 +6468,26 @@ func (ec *executionContext) _Mutation_executePipeline(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.PipelineExecution)
+	res := resTmp.(model.SecretText)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNPipelineExecution2githubᚗcomᚋlectioᚋgraphᚋmodelᚐPipelineExecution(ctx, field.Selections, res)
+	return ec.marshalNSecretText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSecretText(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_executeBookmarksToMarkdownPipeline(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _HTTPClientSettings_store(ctx context.Context, field graphql.CollectedField, obj *model.HTTPClientSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "Mutation",
+		Object:   "HTTPClientSettings",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_executeBookmarksToMarkdownPipeline_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().ExecuteBookmarksToMarkdownPipeline(rctx, args["input"].(model.BookmarksToMarkdownPipelineInput))
+		return obj.Store, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5296,17 +6495,17 @@ func (ec *executionContext) _Mutation_executeBookmarksToMarkdownPipeline(ctx con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.BookmarksToMarkdownPipelineExecution)
+	res := resTmp.(model.SettingsStore)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBookmarksToMarkdownPipelineExecution2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐBookmarksToMarkdownPipelineExecution(ctx, field.Selections, res)
+	return ec.marshalNSettingsStore2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSettingsStore(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NumericProperty_name(ctx context.Context, field graphql.CollectedField, obj *model.NumericProperty) graphql.Marshaler {
+func (ec *executionContext) _HTTPClientSettings_userAgent(ctx context.Context, field graphql.CollectedField, obj *model.HTTPClientSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "NumericProperty",
+		Object:   "HTTPClientSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5315,7 +6514,7 @@ func (ec *executionContext) _NumericProperty_name(ctx context.Context, field gra
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.UserAgent, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5323,17 +6522,17 @@ func (ec *executionContext) _NumericProperty_name(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.PropertyName)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNPropertyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐPropertyName(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NumericProperty_value(ctx context.Context, field graphql.CollectedField, obj *model.NumericProperty) graphql.Marshaler {
+func (ec *executionContext) _HTTPClientSettings_timeout(ctx context.Context, field graphql.CollectedField, obj *model.HTTPClientSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "NumericProperty",
+		Object:   "HTTPClientSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5342,7 +6541,7 @@ func (ec *executionContext) _NumericProperty_value(ctx context.Context, field gr
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Timeout, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5350,17 +6549,17 @@ func (ec *executionContext) _NumericProperty_value(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(model.TimeoutDuration)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNHTTPClientTimeoutDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ObservationSettings_store(ctx context.Context, field graphql.CollectedField, obj *model.ObservationSettings) graphql.Marshaler {
+func (ec *executionContext) _HTTPClientSettings_cache(ctx context.Context, field graphql.CollectedField, obj *model.HTTPClientSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ObservationSettings",
+		Object:   "HTTPClientSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5369,7 +6568,7 @@ func (ec *executionContext) _ObservationSettings_store(ctx context.Context, fiel
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Store, nil
+		return obj.Cache, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5377,17 +6576,17 @@ func (ec *executionContext) _ObservationSettings_store(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.SettingsStore)
+	res := resTmp.(model.HTTPCache)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNSettingsStore2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSettingsStore(ctx, field.Selections, res)
+	return ec.marshalNHTTPCache2githubᚗcomᚋlectioᚋgraphᚋmodelᚐHTTPCache(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ObservationSettings_progressReporterType(ctx context.Context, field graphql.CollectedField, obj *model.ObservationSettings) graphql.Marshaler {
+func (ec *executionContext) _HTTPClientSettings_blockPrivateNetworks(ctx context.Context, field graphql.CollectedField, obj *model.HTTPClientSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "ObservationSettings",
+		Object:   "HTTPClientSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5396,7 +6595,7 @@ func (ec *executionContext) _ObservationSettings_progressReporterType(ctx contex
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ProgressReporterType, nil
+		return obj.BlockPrivateNetworks, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5404,17 +6603,17 @@ func (ec *executionContext) _ObservationSettings_progressReporterType(ctx contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ProgressReporterType)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNProgressReporterType2githubᚗcomᚋlectioᚋgraphᚋmodelᚐProgressReporterType(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Properties_all(ctx context.Context, field graphql.CollectedField, obj *model.Properties) graphql.Marshaler {
+func (ec *executionContext) _HTTPClientSettings_allowedNetworks(ctx context.Context, field graphql.CollectedField, obj *model.HTTPClientSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "Properties",
+		Object:   "HTTPClientSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5423,194 +6622,184 @@ func (ec *executionContext) _Properties_all(ctx context.Context, field graphql.C
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.All, nil
+		return obj.AllowedNetworks, nil
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]model.Property)
+	res := resTmp.([]string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOProperty2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐProperty(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_allSettings(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _HTTPDiskCache_name(ctx context.Context, field graphql.CollectedField, obj *model.HTTPDiskCache) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
+		Object:   "HTTPDiskCache",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().AllSettings(rctx)
+		return obj.Name, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.PersistentSettings)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOPersistentSettings2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐPersistentSettings(ctx, field.Selections, res)
+	return ec.marshalNHTTPCacheName2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_settings(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _HTTPDiskCache_activities(ctx context.Context, field graphql.CollectedField, obj *model.HTTPDiskCache) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
+		Object:   "HTTPDiskCache",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_settings_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Settings(rctx, args["path"].(model.SettingsPath))
+		return obj.Activities, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.PersistentSettings)
+	res := resTmp.(model.Activities)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOPersistentSettings2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐPersistentSettings(ctx, field.Selections, res)
+	return ec.marshalNActivities2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivities(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_source(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _HTTPDiskCache_basePath(ctx context.Context, field graphql.CollectedField, obj *model.HTTPDiskCache) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
+		Object:   "HTTPDiskCache",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_source_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Source(rctx, args["source"].(model.URLText))
+		return obj.BasePath, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ContentSource)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOContentSource2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentSource(ctx, field.Selections, res)
+	return ec.marshalNRelativeDirectoryPath2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_bookmarks(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _HTTPDiskCache_createBasePath(ctx context.Context, field graphql.CollectedField, obj *model.HTTPDiskCache) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
+		Object:   "HTTPDiskCache",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_bookmarks_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Bookmarks(rctx, args["source"].(model.URLText), args["settings"].(model.SettingsPath))
+		return obj.CreateBasePath, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Bookmarks)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOBookmarks2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐBookmarks(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _HTTPMemoryCache_name(ctx context.Context, field graphql.CollectedField, obj *model.HTTPMemoryCache) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
+		Object:   "HTTPMemoryCache",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query___type_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string))
+		return obj.Name, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNHTTPCacheName2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _HTTPMemoryCache_activities(ctx context.Context, field graphql.CollectedField, obj *model.HTTPMemoryCache) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
+		Object:   "HTTPMemoryCache",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.Activities, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(model.Activities)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNActivities2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivities(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Repositories_store(ctx context.Context, field graphql.CollectedField, obj *model.Repositories) graphql.Marshaler {
+func (ec *executionContext) _HiearchicalTaxonomy_name(ctx context.Context, field graphql.CollectedField, obj *model.HiearchicalTaxonomy) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "Repositories",
+		Object:   "HiearchicalTaxonomy",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5619,7 +6808,7 @@ func (ec *executionContext) _Repositories_store(ctx context.Context, field graph
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Store, nil
+		return obj.Name, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5627,17 +6816,17 @@ func (ec *executionContext) _Repositories_store(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.SettingsStore)
+	res := resTmp.(model.TaxonomyName)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNSettingsStore2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSettingsStore(ctx, field.Selections, res)
+	return ec.marshalNTaxonomyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonomyName(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Repositories_all(ctx context.Context, field graphql.CollectedField, obj *model.Repositories) graphql.Marshaler {
+func (ec *executionContext) _HiearchicalTaxonomy_taxa(ctx context.Context, field graphql.CollectedField, obj *model.HiearchicalTaxonomy) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "Repositories",
+		Object:   "HiearchicalTaxonomy",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5646,22 +6835,25 @@ func (ec *executionContext) _Repositories_all(ctx context.Context, field graphql
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.All, nil
+		return obj.Taxa, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.Repository)
+	res := resTmp.([]model.TaxonNode)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalORepository2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐRepository(ctx, field.Selections, res)
+	return ec.marshalNTaxonNode2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonNode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SecretText_vault(ctx context.Context, field graphql.CollectedField, obj *model.SecretText) graphql.Marshaler {
+func (ec *executionContext) _LinkDomainPolicy_domains(ctx context.Context, field graphql.CollectedField, obj *model.LinkDomainPolicy) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "SecretText",
+		Object:   "LinkDomainPolicy",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5670,7 +6862,7 @@ func (ec *executionContext) _SecretText_vault(ctx context.Context, field graphql
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Vault, nil
+		return obj.Domains, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5678,17 +6870,17 @@ func (ec *executionContext) _SecretText_vault(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.SecretsVault)
+	res := resTmp.([]string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNSecretsVault2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSecretsVault(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SecretText_encryptedText(ctx context.Context, field graphql.CollectedField, obj *model.SecretText) graphql.Marshaler {
+func (ec *executionContext) _LinkDomainPolicy_traverseLinks(ctx context.Context, field graphql.CollectedField, obj *model.LinkDomainPolicy) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "SecretText",
+		Object:   "LinkDomainPolicy",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5697,25 +6889,22 @@ func (ec *executionContext) _SecretText_encryptedText(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EncryptedText, nil
+		return obj.TraverseLinks, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SettingsStore_name(ctx context.Context, field graphql.CollectedField, obj *model.SettingsStore) graphql.Marshaler {
+func (ec *executionContext) _LinkDomainPolicy_scoreLinks(ctx context.Context, field graphql.CollectedField, obj *model.LinkDomainPolicy) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "SettingsStore",
+		Object:   "LinkDomainPolicy",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5724,25 +6913,22 @@ func (ec *executionContext) _SettingsStore_name(ctx context.Context, field graph
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ScoreLinks, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.SettingsStoreName)
+	res := resTmp.(*model.LinkScoresLifecycleSettings)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNSettingsStoreName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSettingsStoreName(ctx, field.Selections, res)
+	return ec.marshalOLinkScoresLifecycleSettings2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkScoresLifecycleSettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TaxonNode_taxon(ctx context.Context, field graphql.CollectedField, obj *model.TaxonNode) graphql.Marshaler {
+func (ec *executionContext) _LinkDomainPolicy_followRedirectsInLinkDestinationHTMLContent(ctx context.Context, field graphql.CollectedField, obj *model.LinkDomainPolicy) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "TaxonNode",
+		Object:   "LinkDomainPolicy",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5751,22 +6937,22 @@ func (ec *executionContext) _TaxonNode_taxon(ctx context.Context, field graphql.
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Taxon, nil
+		return obj.FollowRedirectsInLinkDestinationHTMLContent, nil
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.TaxonName)
+	res := resTmp.(*bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOTaxonName2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonName(ctx, field.Selections, res)
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TaxonNode_taxa(ctx context.Context, field graphql.CollectedField, obj *model.TaxonNode) graphql.Marshaler {
+func (ec *executionContext) _LinkDomainPolicy_parseMetaDataInLinkDestinationHTMLContent(ctx context.Context, field graphql.CollectedField, obj *model.LinkDomainPolicy) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "TaxonNode",
+		Object:   "LinkDomainPolicy",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5775,25 +6961,22 @@ func (ec *executionContext) _TaxonNode_taxa(ctx context.Context, field graphql.C
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Taxa, nil
+		return obj.ParseMetaDataInLinkDestinationHTMLContent, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.TaxonNode)
+	res := resTmp.(*bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTaxonNode2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonNode(ctx, field.Selections, res)
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TempFileRepository_name(ctx context.Context, field graphql.CollectedField, obj *model.TempFileRepository) graphql.Marshaler {
+func (ec *executionContext) _LinkDomainPolicy_downloadLinkDestinationAttachments(ctx context.Context, field graphql.CollectedField, obj *model.LinkDomainPolicy) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "TempFileRepository",
+		Object:   "LinkDomainPolicy",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5802,25 +6985,22 @@ func (ec *executionContext) _TempFileRepository_name(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.DownloadLinkDestinationAttachments, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.RepositoryName)
+	res := resTmp.(*bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNRepositoryName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐRepositoryName(ctx, field.Selections, res)
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TempFileRepository_url(ctx context.Context, field graphql.CollectedField, obj *model.TempFileRepository) graphql.Marshaler {
+func (ec *executionContext) _LinkDomainPolicy_userAgent(ctx context.Context, field graphql.CollectedField, obj *model.LinkDomainPolicy) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "TempFileRepository",
+		Object:   "LinkDomainPolicy",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5829,25 +7009,22 @@ func (ec *executionContext) _TempFileRepository_url(ctx context.Context, field g
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URL, nil
+		return obj.UserAgent, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.URLText)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNRepositoryURL2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TempFileRepository_prefix(ctx context.Context, field graphql.CollectedField, obj *model.TempFileRepository) graphql.Marshaler {
+func (ec *executionContext) _LinkDomainPolicy_timeout(ctx context.Context, field graphql.CollectedField, obj *model.LinkDomainPolicy) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "TempFileRepository",
+		Object:   "LinkDomainPolicy",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5856,25 +7033,22 @@ func (ec *executionContext) _TempFileRepository_prefix(ctx context.Context, fiel
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Prefix, nil
+		return obj.Timeout, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.TimeoutDuration)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTempFileRepositoryPrefix2string(ctx, field.Selections, res)
+	return ec.marshalOHTTPClientTimeoutDuration2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TextProperty_name(ctx context.Context, field graphql.CollectedField, obj *model.TextProperty) graphql.Marshaler {
+func (ec *executionContext) _LinkLifecyleSettings_store(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "TextProperty",
+		Object:   "LinkLifecyleSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5883,7 +7057,7 @@ func (ec *executionContext) _TextProperty_name(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Store, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5891,17 +7065,17 @@ func (ec *executionContext) _TextProperty_name(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.PropertyName)
+	res := resTmp.(model.SettingsStore)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNPropertyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐPropertyName(ctx, field.Selections, res)
+	return ec.marshalNSettingsStore2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSettingsStore(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TextProperty_value(ctx context.Context, field graphql.CollectedField, obj *model.TextProperty) graphql.Marshaler {
+func (ec *executionContext) _LinkLifecyleSettings_traverseLinks(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "TextProperty",
+		Object:   "LinkLifecyleSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5910,7 +7084,7 @@ func (ec *executionContext) _TextProperty_value(ctx context.Context, field graph
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.TraverseLinks, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5918,17 +7092,17 @@ func (ec *executionContext) _TextProperty_value(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
+func (ec *executionContext) _LinkLifecyleSettings_scoreLinks(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Directive",
+		Object:   "LinkLifecyleSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5937,7 +7111,7 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ScoreLinks, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -5945,17 +7119,17 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.LinkScoresLifecycleSettings)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLinkScoresLifecycleSettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkScoresLifecycleSettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
+func (ec *executionContext) _LinkLifecyleSettings_ignoreURLsRegExprs(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Directive",
+		Object:   "LinkLifecyleSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5964,22 +7138,22 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.IgnoreURLsRegExprs, nil
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.RegularExpression)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalORegularExpression2ᚕᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐRegularExpression(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
+func (ec *executionContext) _LinkLifecyleSettings_allowURLsRegExprs(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Directive",
+		Object:   "LinkLifecyleSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5988,25 +7162,22 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return obj.AllowURLsRegExprs, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.([]*model.RegularExpression)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__DirectiveLocation2ᚕstring(ctx, field.Selections, res)
+	return ec.marshalORegularExpression2ᚕᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐRegularExpression(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
+func (ec *executionContext) _LinkLifecyleSettings_removeParamsFromURLsRegEx(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Directive",
+		Object:   "LinkLifecyleSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6015,25 +7186,22 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.RemoveParamsFromURLsRegEx, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.([]*model.RegularExpression)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, field.Selections, res)
+	return ec.marshalORegularExpression2ᚕᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐRegularExpression(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
+func (ec *executionContext) _LinkLifecyleSettings_rewriteURLRules(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__EnumValue",
+		Object:   "LinkLifecyleSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6042,25 +7210,22 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.RewriteURLRules, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]model.URLRewriteRule)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOURLRewriteRule2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐURLRewriteRule(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
+func (ec *executionContext) _LinkLifecyleSettings_followRedirectsInLinkDestinationHTMLContent(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__EnumValue",
+		Object:   "LinkLifecyleSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6069,31 +7234,34 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.FollowRedirectsInLinkDestinationHTMLContent, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
+func (ec *executionContext) _LinkLifecyleSettings_parseMetaDataInLinkDestinationHTMLContent(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__EnumValue",
+		Object:   "LinkLifecyleSettings",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.ParseMetaDataInLinkDestinationHTMLContent, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -6107,35 +7275,38 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
+func (ec *executionContext) _LinkLifecyleSettings_downloadLinkDestinationAttachments(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__EnumValue",
+		Object:   "LinkLifecyleSettings",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.DownloadLinkDestinationAttachments, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+func (ec *executionContext) _LinkLifecyleSettings_maxConcurrentHarvests(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Field",
+		Object:   "LinkLifecyleSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6144,7 +7315,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.MaxConcurrentHarvests, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -6152,17 +7323,17 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+func (ec *executionContext) _LinkLifecyleSettings_maxConcurrentHarvestsPerHost(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Field",
+		Object:   "LinkLifecyleSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6171,22 +7342,25 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.MaxConcurrentHarvestsPerHost, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+func (ec *executionContext) _LinkLifecyleSettings_retry(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Field",
+		Object:   "LinkLifecyleSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6195,7 +7369,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.Retry, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -6203,17 +7377,17 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(model.LinkRetrySettings)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, field.Selections, res)
+	return ec.marshalNLinkRetrySettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkRetrySettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+func (ec *executionContext) _LinkLifecyleSettings_robots(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Field",
+		Object:   "LinkLifecyleSettings",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6222,7 +7396,7 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Robots, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -6230,68 +7404,68 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(model.LinkRobotsSettings)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNLinkRobotsSettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkRobotsSettings(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+func (ec *executionContext) _LinkLifecyleSettings_domainPolicies(ctx context.Context, field graphql.CollectedField, obj *model.LinkLifecyleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Field",
+		Object:   "LinkLifecyleSettings",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.DomainPolicies, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]model.LinkDomainPolicy)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOLinkDomainPolicy2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkDomainPolicy(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+func (ec *executionContext) _LinkRedirect_url(ctx context.Context, field graphql.CollectedField, obj *model.LinkRedirect) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Field",
+		Object:   "LinkRedirect",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.URL, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(model.URLText)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNURLText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
+func (ec *executionContext) _LinkRedirect_statusCode(ctx context.Context, field graphql.CollectedField, obj *model.LinkRedirect) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__InputValue",
+		Object:   "LinkRedirect",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6300,7 +7474,7 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.StatusCode, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -6308,17 +7482,17 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
+func (ec *executionContext) _LinkRedirect_redirect(ctx context.Context, field graphql.CollectedField, obj *model.LinkRedirect) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__InputValue",
+		Object:   "LinkRedirect",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6327,22 +7501,22 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Redirect, nil
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.LinkRedirectType)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOLinkRedirectType2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkRedirectType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
+func (ec *executionContext) _LinkRedirect_duration(ctx context.Context, field graphql.CollectedField, obj *model.LinkRedirect) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__InputValue",
+		Object:   "LinkRedirect",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6351,7 +7525,7 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Duration, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -6359,17 +7533,17 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(model.TimeoutDuration)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNLinkRedirectDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
+func (ec *executionContext) _LinkRedirect_error(ctx context.Context, field graphql.CollectedField, obj *model.LinkRedirect) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__InputValue",
+		Object:   "LinkRedirect",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6378,7 +7552,7 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
+		return obj.Error, nil
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -6389,20 +7563,20 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
+func (ec *executionContext) _LinkRetrySettings_maxAttempts(ctx context.Context, field graphql.CollectedField, obj *model.LinkRetrySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Schema",
+		Object:   "LinkRetrySettings",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
+		return obj.MaxAttempts, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -6410,26 +7584,26 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
+func (ec *executionContext) _LinkRetrySettings_initialBackoff(ctx context.Context, field graphql.CollectedField, obj *model.LinkRetrySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Schema",
+		Object:   "LinkRetrySettings",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
+		return obj.InitialBackoff, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -6437,74 +7611,77 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(model.TimeoutDuration)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNLinkRetryDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
+func (ec *executionContext) _LinkRetrySettings_maxBackoff(ctx context.Context, field graphql.CollectedField, obj *model.LinkRetrySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Schema",
+		Object:   "LinkRetrySettings",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
+		return obj.MaxBackoff, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(model.TimeoutDuration)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNLinkRetryDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
+func (ec *executionContext) _LinkRetrySettings_retryStatusCodes(ctx context.Context, field graphql.CollectedField, obj *model.LinkRetrySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Schema",
+		Object:   "LinkRetrySettings",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+		return obj.RetryStatusCodes, nil
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚕint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
+func (ec *executionContext) _LinkRetrySettings_circuitBreakerFailures(ctx context.Context, field graphql.CollectedField, obj *model.LinkRetrySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Schema",
+		Object:   "LinkRetrySettings",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
+		return obj.CircuitBreakerFailures, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -6512,26 +7689,26 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) _LinkRetrySettings_circuitBreakerCooldown(ctx context.Context, field graphql.CollectedField, obj *model.LinkRetrySettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
+		Object:   "LinkRetrySettings",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
+		return obj.CircuitBreakerCooldown, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -6539,237 +7716,2883 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.TimeoutDuration)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec.marshalNLinkRetryDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) _LinkRobotsSettings_honorRobotsTxt(ctx context.Context, field graphql.CollectedField, obj *model.LinkRobotsSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
+		Object:   "LinkRobotsSettings",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
+		return obj.HonorRobotsTxt, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) _LinkRobotsSettings_cacheDuration(ctx context.Context, field graphql.CollectedField, obj *model.LinkRobotsSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
+		Object:   "LinkRobotsSettings",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.CacheDuration, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.TimeoutDuration)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNLinkRobotsDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) _LinkRobotsSettings_maxCrawlDelay(ctx context.Context, field graphql.CollectedField, obj *model.LinkRobotsSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
+		Object:   "LinkRobotsSettings",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_fields_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(args["includeDeprecated"].(bool)), nil
+		return obj.MaxCrawlDelay, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
+	res := resTmp.(model.TimeoutDuration)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx, field.Selections, res)
+	return ec.marshalNLinkRobotsDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) _LinkScoresLifecycleSettings_score(ctx context.Context, field graphql.CollectedField, obj *model.LinkScoresLifecycleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
+		Object:   "LinkScoresLifecycleSettings",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
+		return obj.Score, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) _LinkScoresLifecycleSettings_simulate(ctx context.Context, field graphql.CollectedField, obj *model.LinkScoresLifecycleSettings) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
+		Object:   "LinkScoresLifecycleSettings",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
+		return obj.Simulate, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) _LinkedInLinkScorer_machineName(ctx context.Context, field graphql.CollectedField, obj *model.LinkedInLinkScorer) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
+		Object:   "LinkedInLinkScorer",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
+		return obj.MachineName, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx, field.Selections, res)
+	return ec.marshalNLinkScorerMachineName2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) _LinkedInLinkScorer_humanName(ctx context.Context, field graphql.CollectedField, obj *model.LinkedInLinkScorer) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
+		Object:   "LinkedInLinkScorer",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
+		return obj.HumanName, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, field.Selections, res)
+	return ec.marshalNLinkScorerHumanName2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) _LinkedInLinkScores_scorer(ctx context.Context, field graphql.CollectedField, obj *model.LinkedInLinkScores) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
+		Object:   "LinkedInLinkScores",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
+		return obj.Scorer, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(model.LinkScorer)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNLinkScorer2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkScorer(ctx, field.Selections, res)
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-func (ec *executionContext) unmarshalInputBookmarksToMarkdownPipelineInput(ctx context.Context, v interface{}) (model.BookmarksToMarkdownPipelineInput, error) {
-	var it model.BookmarksToMarkdownPipelineInput
-	var asMap = v.(map[string]interface{})
-
-	if _, present := asMap["strategy"]; !present {
-		asMap["strategy"] = "Asynchronous"
-	}
-	if _, present := asMap["settings"]; !present {
-		asMap["settings"] = "DEFAULT"
+func (ec *executionContext) _LinkedInLinkScores_isValid(ctx context.Context, field graphql.CollectedField, obj *model.LinkedInLinkScores) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "LinkedInLinkScores",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
 	}
-	if _, present := asMap["repository"]; !present {
-		asMap["repository"] = "TEMP"
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsValid, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
 	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
 
-	for k, v := range asMap {
+func (ec *executionContext) _LinkedInLinkScores_targetURL(ctx context.Context, field graphql.CollectedField, obj *model.LinkedInLinkScores) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "LinkedInLinkScores",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TargetURL, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.URLText)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNURLText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _LinkedInLinkScores_sharesCount(ctx context.Context, field graphql.CollectedField, obj *model.LinkedInLinkScores) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "LinkedInLinkScores",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SharesCount, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _LinkedInLinkScores_commentsCount(ctx context.Context, field graphql.CollectedField, obj *model.LinkedInLinkScores) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "LinkedInLinkScores",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CommentsCount, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MarkdownGeneratorSettings_store(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "MarkdownGeneratorSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Store, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SettingsStore)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNSettingsStore2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSettingsStore(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MarkdownGeneratorSettings_cancelOnWriteErrors(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "MarkdownGeneratorSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CancelOnWriteErrors, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MarkdownGeneratorSettings_contentPath(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "MarkdownGeneratorSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ContentPath, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNRelativeDirectoryPath2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MarkdownGeneratorSettings_imagesPath(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "MarkdownGeneratorSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ImagesPath, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNRelativeDirectoryPath2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MarkdownGeneratorSettings_imagesURLRel(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "MarkdownGeneratorSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ImagesURLRel, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.URLText)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNURLText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MarkdownGeneratorSettings_attachmentsPath(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "MarkdownGeneratorSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AttachmentsPath, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNRelativeDirectoryPath2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MarkdownGeneratorSettings_attachmentsURLRel(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "MarkdownGeneratorSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AttachmentsURLRel, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.URLText)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNURLText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MarkdownGeneratorSettings_syncStatePath(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "MarkdownGeneratorSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SyncStatePath, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNRelativeDirectoryPath2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MarkdownGeneratorSettings_deletedContentPolicy(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "MarkdownGeneratorSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeletedContentPolicy, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.DeletedContentPolicy)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNDeletedContentPolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐDeletedContentPolicy(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MarkdownGeneratorSettings_archivePath(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "MarkdownGeneratorSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ArchivePath, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNRelativeDirectoryPath2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MarkdownGeneratorSettings_redirectChainInFrontMatter(ctx context.Context, field graphql.CollectedField, obj *model.MarkdownGeneratorSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "MarkdownGeneratorSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RedirectChainInFrontMatter, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_executePipeline(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_executePipeline_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ExecutePipeline(rctx, args["input"].(model.ExecutePipelineInput))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.PipelineExecution)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNPipelineExecution2githubᚗcomᚋlectioᚋgraphᚋmodelᚐPipelineExecution(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_executeBookmarksToMarkdownPipeline(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_executeBookmarksToMarkdownPipeline_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ExecuteBookmarksToMarkdownPipeline(rctx, args["input"].(model.BookmarksToMarkdownPipelineInput))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.BookmarksToMarkdownPipelineExecution)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBookmarksToMarkdownPipelineExecution2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐBookmarksToMarkdownPipelineExecution(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NumericProperty_name(ctx context.Context, field graphql.CollectedField, obj *model.NumericProperty) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "NumericProperty",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.PropertyName)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNPropertyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐPropertyName(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NumericProperty_value(ctx context.Context, field graphql.CollectedField, obj *model.NumericProperty) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "NumericProperty",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ObservationSettings_store(ctx context.Context, field graphql.CollectedField, obj *model.ObservationSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "ObservationSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Store, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SettingsStore)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNSettingsStore2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSettingsStore(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ObservationSettings_progressReporterType(ctx context.Context, field graphql.CollectedField, obj *model.ObservationSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "ObservationSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ProgressReporterType, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ProgressReporterType)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProgressReporterType2githubᚗcomᚋlectioᚋgraphᚋmodelᚐProgressReporterType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Properties_all(ctx context.Context, field graphql.CollectedField, obj *model.Properties) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Properties",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.All, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.Property)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOProperty2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐProperty(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_allSettings(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AllSettings(rctx)
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.PersistentSettings)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOPersistentSettings2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐPersistentSettings(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_settings(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_settings_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Settings(rctx, args["path"].(model.SettingsPath))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.PersistentSettings)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOPersistentSettings2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐPersistentSettings(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_sources(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Sources(rctx)
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.RegisteredSource)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalORegisteredSource2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐRegisteredSource(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_source(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_source_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Source(rctx, args["source"].(model.URLText))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(model.ContentSource)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOContentSource2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentSource(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_bookmarks(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_bookmarks_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Bookmarks(rctx, args["source"].(model.URLText), args["settings"].(model.SettingsPath))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Bookmarks)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOBookmarks2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐBookmarks(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query___type_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(args["name"].(string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RegisteredSource_name(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredSource) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "RegisteredSource",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.NameText)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNNameText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐNameText(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RegisteredSource_urlPattern(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredSource) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "RegisteredSource",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URLPattern, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.RegularExpression)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalORegularExpression2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐRegularExpression(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Repositories_store(ctx context.Context, field graphql.CollectedField, obj *model.Repositories) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Repositories",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Store, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SettingsStore)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNSettingsStore2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSettingsStore(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Repositories_all(ctx context.Context, field graphql.CollectedField, obj *model.Repositories) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Repositories",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.All, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.Repository)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalORepository2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐRepository(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SecretText_vault(ctx context.Context, field graphql.CollectedField, obj *model.SecretText) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "SecretText",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Vault, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SecretsVault)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNSecretsVault2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSecretsVault(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SecretText_encryptedText(ctx context.Context, field graphql.CollectedField, obj *model.SecretText) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "SecretText",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EncryptedText, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SettingsStore_name(ctx context.Context, field graphql.CollectedField, obj *model.SettingsStore) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "SettingsStore",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SettingsStoreName)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNSettingsStoreName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSettingsStoreName(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SourceSettings_store(ctx context.Context, field graphql.CollectedField, obj *model.SourceSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "SourceSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Store, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SettingsStore)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNSettingsStore2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSettingsStore(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SourceSettings_outlinePolicy(ctx context.Context, field graphql.CollectedField, obj *model.SourceSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "SourceSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OutlinePolicy, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SourceOutlinePolicy)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNSourceOutlinePolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSourceOutlinePolicy(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SourceSettings_columns(ctx context.Context, field graphql.CollectedField, obj *model.SourceSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "SourceSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Columns, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.TabularColumnMapping)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTabularColumnMapping2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTabularColumnMapping(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SourceSettings_localFilesRootPath(ctx context.Context, field graphql.CollectedField, obj *model.SourceSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "SourceSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LocalFilesRootPath, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SourceSettings_allowStdin(ctx context.Context, field graphql.CollectedField, obj *model.SourceSettings) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "SourceSettings",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AllowStdin, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TabularColumnMapping_url(ctx context.Context, field graphql.CollectedField, obj *model.TabularColumnMapping) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TabularColumnMapping",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TabularColumnMapping_title(ctx context.Context, field graphql.CollectedField, obj *model.TabularColumnMapping) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TabularColumnMapping",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TabularColumnMapping_tags(ctx context.Context, field graphql.CollectedField, obj *model.TabularColumnMapping) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TabularColumnMapping",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Tags, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TabularColumnMapping_tagsDelimiter(ctx context.Context, field graphql.CollectedField, obj *model.TabularColumnMapping) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TabularColumnMapping",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TagsDelimiter, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TabularColumnMapping_date(ctx context.Context, field graphql.CollectedField, obj *model.TabularColumnMapping) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TabularColumnMapping",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Date, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TaxonNode_taxon(ctx context.Context, field graphql.CollectedField, obj *model.TaxonNode) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TaxonNode",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Taxon, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TaxonName)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOTaxonName2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonName(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TaxonNode_taxa(ctx context.Context, field graphql.CollectedField, obj *model.TaxonNode) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TaxonNode",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Taxa, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.TaxonNode)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTaxonNode2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonNode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TaxonSynonyms_preferred(ctx context.Context, field graphql.CollectedField, obj *model.TaxonSynonyms) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TaxonSynonyms",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Preferred, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.TaxonName)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTaxonName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonName(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TaxonSynonyms_synonyms(ctx context.Context, field graphql.CollectedField, obj *model.TaxonSynonyms) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TaxonSynonyms",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Synonyms, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.TaxonName)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOTaxonName2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonName(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TaxonomyNormalization_taxonomy(ctx context.Context, field graphql.CollectedField, obj *model.TaxonomyNormalization) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TaxonomyNormalization",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Taxonomy, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.TaxonomyName)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTaxonomyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonomyName(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TaxonomyNormalization_foldCase(ctx context.Context, field graphql.CollectedField, obj *model.TaxonomyNormalization) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TaxonomyNormalization",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FoldCase, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TaxonomyNormalization_slug(ctx context.Context, field graphql.CollectedField, obj *model.TaxonomyNormalization) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TaxonomyNormalization",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Slug, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TaxonomyNormalization_synonyms(ctx context.Context, field graphql.CollectedField, obj *model.TaxonomyNormalization) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TaxonomyNormalization",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Synonyms, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.TaxonSynonyms)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOTaxonSynonyms2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonSynonyms(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TaxonomyNormalization_warnUnknownTaxa(ctx context.Context, field graphql.CollectedField, obj *model.TaxonomyNormalization) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TaxonomyNormalization",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.WarnUnknownTaxa, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempFileRepository_name(ctx context.Context, field graphql.CollectedField, obj *model.TempFileRepository) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TempFileRepository",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.RepositoryName)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNRepositoryName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐRepositoryName(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempFileRepository_url(ctx context.Context, field graphql.CollectedField, obj *model.TempFileRepository) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TempFileRepository",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.URLText)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNRepositoryURL2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempFileRepository_prefix(ctx context.Context, field graphql.CollectedField, obj *model.TempFileRepository) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TempFileRepository",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Prefix, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTempFileRepositoryPrefix2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TextProperty_name(ctx context.Context, field graphql.CollectedField, obj *model.TextProperty) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TextProperty",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.PropertyName)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNPropertyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐPropertyName(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TextProperty_value(ctx context.Context, field graphql.CollectedField, obj *model.TextProperty) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "TextProperty",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _URLRewrite_rule(ctx context.Context, field graphql.CollectedField, obj *model.URLRewrite) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "URLRewrite",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Rule, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.NameText)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNNameText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐNameText(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _URLRewrite_from(ctx context.Context, field graphql.CollectedField, obj *model.URLRewrite) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "URLRewrite",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.From, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.URLText)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNURLText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _URLRewrite_to(ctx context.Context, field graphql.CollectedField, obj *model.URLRewrite) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "URLRewrite",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.To, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.URLText)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNURLText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _URLRewriteRule_name(ctx context.Context, field graphql.CollectedField, obj *model.URLRewriteRule) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "URLRewriteRule",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.NameText)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNNameText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐNameText(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _URLRewriteRule_type(ctx context.Context, field graphql.CollectedField, obj *model.URLRewriteRule) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "URLRewriteRule",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.URLRewriteRuleType)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNURLRewriteRuleType2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLRewriteRuleType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _URLRewriteRule_match(ctx context.Context, field graphql.CollectedField, obj *model.URLRewriteRule) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "URLRewriteRule",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Match, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.RegularExpression)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalORegularExpression2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐRegularExpression(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _URLRewriteRule_replacement(ctx context.Context, field graphql.CollectedField, obj *model.URLRewriteRule) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "URLRewriteRule",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Replacement, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Directive",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Directive",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Directive",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalN__DirectiveLocation2ᚕstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Directive",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__EnumValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__EnumValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__EnumValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__EnumValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__InputValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__InputValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__InputValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__InputValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Schema",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Schema",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Schema",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Schema",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Schema",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_fields_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(args["includeDeprecated"].(bool)), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputBookmarksToMarkdownPipelineInput(ctx context.Context, v interface{}) (model.BookmarksToMarkdownPipelineInput, error) {
+	var it model.BookmarksToMarkdownPipelineInput
+	var asMap = v.(map[string]interface{})
+
+	if _, present := asMap["strategy"]; !present {
+		asMap["strategy"] = "Asynchronous"
+	}
+	if _, present := asMap["settings"]; !present {
+		asMap["settings"] = "DEFAULT"
+	}
+	if _, present := asMap["repository"]; !present {
+		asMap["repository"] = "TEMP"
+	}
+	if _, present := asMap["incremental"]; !present {
+		asMap["incremental"] = false
+	}
+
+	for k, v := range asMap {
 		switch k {
 		case "strategy":
 			var err error
@@ -6795,6 +10618,18 @@ func (ec *executionContext) unmarshalInputBookmarksToMarkdownPipelineInput(ctx c
 			if err != nil {
 				return it, err
 			}
+		case "incremental":
+			var err error
+			it.Incremental, err = ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "timeout":
+			var err error
+			it.Timeout, err = ec.unmarshalOPipelineTimeoutDuration2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
 	}
 
@@ -7041,6 +10876,10 @@ func (ec *executionContext) _PersistentSettings(ctx context.Context, sel ast.Sel
 		return ec._ObservationSettings(ctx, sel, &obj)
 	case *model.ObservationSettings:
 		return ec._ObservationSettings(ctx, sel, obj)
+	case model.SourceSettings:
+		return ec._SourceSettings(ctx, sel, &obj)
+	case *model.SourceSettings:
+		return ec._SourceSettings(ctx, sel, obj)
 	default:
 		panic(fmt.Errorf("unexpected type %T", obj))
 	}
@@ -7429,6 +11268,8 @@ func (ec *executionContext) _Bookmark(ctx context.Context, sel ast.SelectionSet,
 			out.Values[i] = ec._Bookmark_properties(ctx, field, obj)
 		case "scores":
 			out.Values[i] = ec._Bookmark_scores(ctx, field, obj)
+		case "categorizations":
+			out.Values[i] = ec._Bookmark_categorizations(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7468,6 +11309,12 @@ func (ec *executionContext) _BookmarkLink(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
+		case "rewrites":
+			out.Values[i] = ec._BookmarkLink_rewrites(ctx, field, obj)
+		case "canonicalURL":
+			out.Values[i] = ec._BookmarkLink_canonicalURL(ctx, field, obj)
+		case "redirectChain":
+			out.Values[i] = ec._BookmarkLink_redirectChain(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7500,15 +11347,285 @@ func (ec *executionContext) _Bookmarks(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "content":
-			out.Values[i] = ec._Bookmarks_content(ctx, field, obj)
-		case "activities":
-			out.Values[i] = ec._Bookmarks_activities(ctx, field, obj)
+		case "content":
+			out.Values[i] = ec._Bookmarks_content(ctx, field, obj)
+		case "activities":
+			out.Values[i] = ec._Bookmarks_activities(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "properties":
+			out.Values[i] = ec._Bookmarks_properties(ctx, field, obj)
+		case "sourceLinks":
+			out.Values[i] = ec._Bookmarks_sourceLinks(ctx, field, obj)
+		case "sourceLinksComplete":
+			out.Values[i] = ec._Bookmarks_sourceLinksComplete(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var bookmarksAPISourceImplementors = []string{"BookmarksAPISource", "ContentSource", "APISource"}
+
+func (ec *executionContext) _BookmarksAPISource(ctx context.Context, sel ast.SelectionSet, obj *model.BookmarksAPISource) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, bookmarksAPISourceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BookmarksAPISource")
+		case "name":
+			out.Values[i] = ec._BookmarksAPISource_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "apiEndpoint":
+			out.Values[i] = ec._BookmarksAPISource_apiEndpoint(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var bookmarksToMarkdownPipelineExecutionImplementors = []string{"BookmarksToMarkdownPipelineExecution", "PipelineExecution"}
+
+func (ec *executionContext) _BookmarksToMarkdownPipelineExecution(ctx context.Context, sel ast.SelectionSet, obj *model.BookmarksToMarkdownPipelineExecution) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, bookmarksToMarkdownPipelineExecutionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BookmarksToMarkdownPipelineExecution")
+		case "pipeline":
+			out.Values[i] = ec._BookmarksToMarkdownPipelineExecution_pipeline(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "strategy":
+			out.Values[i] = ec._BookmarksToMarkdownPipelineExecution_strategy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "executionID":
+			out.Values[i] = ec._BookmarksToMarkdownPipelineExecution_executionID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "bookmarks":
+			out.Values[i] = ec._BookmarksToMarkdownPipelineExecution_bookmarks(ctx, field, obj)
+		case "activities":
+			out.Values[i] = ec._BookmarksToMarkdownPipelineExecution_activities(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var categorizationImplementors = []string{"Categorization"}
+
+func (ec *executionContext) _Categorization(ctx context.Context, sel ast.SelectionSet, obj *model.Categorization) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, categorizationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Categorization")
+		case "taxonomy":
+			out.Values[i] = ec._Categorization_taxonomy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "taxon":
+			out.Values[i] = ec._Categorization_taxon(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "rule":
+			out.Values[i] = ec._Categorization_rule(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var contentBodySettingsImplementors = []string{"ContentBodySettings"}
+
+func (ec *executionContext) _ContentBodySettings(ctx context.Context, sel ast.SelectionSet, obj *model.ContentBodySettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, contentBodySettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ContentBodySettings")
+		case "allowFrontmatter":
+			out.Values[i] = ec._ContentBodySettings_allowFrontmatter(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "frontMatterPropertyNamePrefix":
+			out.Values[i] = ec._ContentBodySettings_frontMatterPropertyNamePrefix(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "extractPolicy":
+			out.Values[i] = ec._ContentBodySettings_extractPolicy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "extractMinLength":
+			out.Values[i] = ec._ContentBodySettings_extractMinLength(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var contentCategorizationRuleImplementors = []string{"ContentCategorizationRule"}
+
+func (ec *executionContext) _ContentCategorizationRule(ctx context.Context, sel ast.SelectionSet, obj *model.ContentCategorizationRule) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, contentCategorizationRuleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ContentCategorizationRule")
+		case "name":
+			out.Values[i] = ec._ContentCategorizationRule_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "domains":
+			out.Values[i] = ec._ContentCategorizationRule_domains(ctx, field, obj)
+		case "urlRegExpr":
+			out.Values[i] = ec._ContentCategorizationRule_urlRegExpr(ctx, field, obj)
+		case "titleKeywords":
+			out.Values[i] = ec._ContentCategorizationRule_titleKeywords(ctx, field, obj)
+		case "bodyKeywords":
+			out.Values[i] = ec._ContentCategorizationRule_bodyKeywords(ctx, field, obj)
+		case "propertyName":
+			out.Values[i] = ec._ContentCategorizationRule_propertyName(ctx, field, obj)
+		case "propertyValueRegExpr":
+			out.Values[i] = ec._ContentCategorizationRule_propertyValueRegExpr(ctx, field, obj)
+		case "taxonomy":
+			out.Values[i] = ec._ContentCategorizationRule_taxonomy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "taxa":
+			out.Values[i] = ec._ContentCategorizationRule_taxa(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var contentEditActivityImplementors = []string{"ContentEditActivity", "Activity"}
+
+func (ec *executionContext) _ContentEditActivity(ctx context.Context, sel ast.SelectionSet, obj *model.ContentEditActivity) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, contentEditActivityImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ContentEditActivity")
+		case "id":
+			out.Values[i] = ec._ContentEditActivity_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "context":
+			out.Values[i] = ec._ContentEditActivity_context(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "code":
+			out.Values[i] = ec._ContentEditActivity_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "name":
+			out.Values[i] = ec._ContentEditActivity_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "message":
+			out.Values[i] = ec._ContentEditActivity_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "properties":
+			out.Values[i] = ec._ContentEditActivity_properties(ctx, field, obj)
+		case "original":
+			out.Values[i] = ec._ContentEditActivity_original(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modified":
+			out.Values[i] = ec._ContentEditActivity_modified(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "properties":
-			out.Values[i] = ec._Bookmarks_properties(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7520,71 +11637,49 @@ func (ec *executionContext) _Bookmarks(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var bookmarksAPISourceImplementors = []string{"BookmarksAPISource", "ContentSource", "APISource"}
+var contentKeywordsSettingsImplementors = []string{"ContentKeywordsSettings"}
 
-func (ec *executionContext) _BookmarksAPISource(ctx context.Context, sel ast.SelectionSet, obj *model.BookmarksAPISource) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, bookmarksAPISourceImplementors)
+func (ec *executionContext) _ContentKeywordsSettings(ctx context.Context, sel ast.SelectionSet, obj *model.ContentKeywordsSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, contentKeywordsSettingsImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	invalid := false
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("BookmarksAPISource")
-		case "name":
-			out.Values[i] = ec._BookmarksAPISource_name(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ContentKeywordsSettings")
+		case "extractKeywords":
+			out.Values[i] = ec._ContentKeywordsSettings_extractKeywords(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "apiEndpoint":
-			out.Values[i] = ec._BookmarksAPISource_apiEndpoint(ctx, field, obj)
+		case "keywordsTaxonomy":
+			out.Values[i] = ec._ContentKeywordsSettings_keywordsTaxonomy(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalid {
-		return graphql.Null
-	}
-	return out
-}
-
-var bookmarksToMarkdownPipelineExecutionImplementors = []string{"BookmarksToMarkdownPipelineExecution", "PipelineExecution"}
-
-func (ec *executionContext) _BookmarksToMarkdownPipelineExecution(ctx context.Context, sel ast.SelectionSet, obj *model.BookmarksToMarkdownPipelineExecution) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, bookmarksToMarkdownPipelineExecutionImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	invalid := false
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("BookmarksToMarkdownPipelineExecution")
-		case "pipeline":
-			out.Values[i] = ec._BookmarksToMarkdownPipelineExecution_pipeline(ctx, field, obj)
+		case "extractEntities":
+			out.Values[i] = ec._ContentKeywordsSettings_extractEntities(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "strategy":
-			out.Values[i] = ec._BookmarksToMarkdownPipelineExecution_strategy(ctx, field, obj)
+		case "entitiesTaxonomy":
+			out.Values[i] = ec._ContentKeywordsSettings_entitiesTaxonomy(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "executionID":
-			out.Values[i] = ec._BookmarksToMarkdownPipelineExecution_executionID(ctx, field, obj)
+		case "maxTerms":
+			out.Values[i] = ec._ContentKeywordsSettings_maxTerms(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "bookmarks":
-			out.Values[i] = ec._BookmarksToMarkdownPipelineExecution_bookmarks(ctx, field, obj)
-		case "activities":
-			out.Values[i] = ec._BookmarksToMarkdownPipelineExecution_activities(ctx, field, obj)
+		case "minFrequency":
+			out.Values[i] = ec._ContentKeywordsSettings_minFrequency(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
+		case "stopWords":
+			out.Values[i] = ec._ContentKeywordsSettings_stopWords(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7596,24 +11691,34 @@ func (ec *executionContext) _BookmarksToMarkdownPipelineExecution(ctx context.Co
 	return out
 }
 
-var contentBodySettingsImplementors = []string{"ContentBodySettings"}
+var contentMetadataSettingsImplementors = []string{"ContentMetadataSettings"}
 
-func (ec *executionContext) _ContentBodySettings(ctx context.Context, sel ast.SelectionSet, obj *model.ContentBodySettings) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, contentBodySettingsImplementors)
+func (ec *executionContext) _ContentMetadataSettings(ctx context.Context, sel ast.SelectionSet, obj *model.ContentMetadataSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, contentMetadataSettingsImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	invalid := false
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ContentBodySettings")
-		case "allowFrontmatter":
-			out.Values[i] = ec._ContentBodySettings_allowFrontmatter(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ContentMetadataSettings")
+		case "fillMissingTitle":
+			out.Values[i] = ec._ContentMetadataSettings_fillMissingTitle(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "frontMatterPropertyNamePrefix":
-			out.Values[i] = ec._ContentBodySettings_frontMatterPropertyNamePrefix(ctx, field, obj)
+		case "fillMissingSummary":
+			out.Values[i] = ec._ContentMetadataSettings_fillMissingSummary(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "fillMissingDate":
+			out.Values[i] = ec._ContentMetadataSettings_fillMissingDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "fillMissingFeaturedImage":
+			out.Values[i] = ec._ContentMetadataSettings_fillMissingFeaturedImage(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
@@ -7628,54 +11733,54 @@ func (ec *executionContext) _ContentBodySettings(ctx context.Context, sel ast.Se
 	return out
 }
 
-var contentEditActivityImplementors = []string{"ContentEditActivity", "Activity"}
+var contentSettingsImplementors = []string{"ContentSettings", "PersistentSettings"}
 
-func (ec *executionContext) _ContentEditActivity(ctx context.Context, sel ast.SelectionSet, obj *model.ContentEditActivity) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, contentEditActivityImplementors)
+func (ec *executionContext) _ContentSettings(ctx context.Context, sel ast.SelectionSet, obj *model.ContentSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, contentSettingsImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	invalid := false
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ContentEditActivity")
-		case "id":
-			out.Values[i] = ec._ContentEditActivity_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ContentSettings")
+		case "store":
+			out.Values[i] = ec._ContentSettings_store(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "context":
-			out.Values[i] = ec._ContentEditActivity_context(ctx, field, obj)
+		case "title":
+			out.Values[i] = ec._ContentSettings_title(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "code":
-			out.Values[i] = ec._ContentEditActivity_code(ctx, field, obj)
+		case "summary":
+			out.Values[i] = ec._ContentSettings_summary(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "name":
-			out.Values[i] = ec._ContentEditActivity_name(ctx, field, obj)
+		case "body":
+			out.Values[i] = ec._ContentSettings_body(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "message":
-			out.Values[i] = ec._ContentEditActivity_message(ctx, field, obj)
+		case "metadata":
+			out.Values[i] = ec._ContentSettings_metadata(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "properties":
-			out.Values[i] = ec._ContentEditActivity_properties(ctx, field, obj)
-		case "original":
-			out.Values[i] = ec._ContentEditActivity_original(ctx, field, obj)
+		case "taxonomies":
+			out.Values[i] = ec._ContentSettings_taxonomies(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "modified":
-			out.Values[i] = ec._ContentEditActivity_modified(ctx, field, obj)
+		case "keywords":
+			out.Values[i] = ec._ContentSettings_keywords(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
+		case "categorizationRules":
+			out.Values[i] = ec._ContentSettings_categorizationRules(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7687,34 +11792,39 @@ func (ec *executionContext) _ContentEditActivity(ctx context.Context, sel ast.Se
 	return out
 }
 
-var contentSettingsImplementors = []string{"ContentSettings", "PersistentSettings"}
+var contentSummarySettingsImplementors = []string{"ContentSummarySettings"}
 
-func (ec *executionContext) _ContentSettings(ctx context.Context, sel ast.SelectionSet, obj *model.ContentSettings) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, contentSettingsImplementors)
+func (ec *executionContext) _ContentSummarySettings(ctx context.Context, sel ast.SelectionSet, obj *model.ContentSummarySettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, contentSummarySettingsImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	invalid := false
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ContentSettings")
-		case "store":
-			out.Values[i] = ec._ContentSettings_store(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ContentSummarySettings")
+		case "policy":
+			out.Values[i] = ec._ContentSummarySettings_policy(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "title":
-			out.Values[i] = ec._ContentSettings_title(ctx, field, obj)
+		case "sentenceSplitter":
+			out.Values[i] = ec._ContentSummarySettings_sentenceSplitter(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "summary":
-			out.Values[i] = ec._ContentSettings_summary(ctx, field, obj)
+		case "extractiveSentences":
+			out.Values[i] = ec._ContentSummarySettings_extractiveSentences(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "body":
-			out.Values[i] = ec._ContentSettings_body(ctx, field, obj)
+		case "extractiveMaxLength":
+			out.Values[i] = ec._ContentSummarySettings_extractiveMaxLength(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "fallbackToMetaDescription":
+			out.Values[i] = ec._ContentSummarySettings_fallbackToMetaDescription(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
@@ -7729,22 +11839,24 @@ func (ec *executionContext) _ContentSettings(ctx context.Context, sel ast.Select
 	return out
 }
 
-var contentSummarySettingsImplementors = []string{"ContentSummarySettings"}
+var contentTaxonomySettingsImplementors = []string{"ContentTaxonomySettings"}
 
-func (ec *executionContext) _ContentSummarySettings(ctx context.Context, sel ast.SelectionSet, obj *model.ContentSummarySettings) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, contentSummarySettingsImplementors)
+func (ec *executionContext) _ContentTaxonomySettings(ctx context.Context, sel ast.SelectionSet, obj *model.ContentTaxonomySettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, contentTaxonomySettingsImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	invalid := false
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ContentSummarySettings")
-		case "policy":
-			out.Values[i] = ec._ContentSummarySettings_policy(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ContentTaxonomySettings")
+		case "tagPathDelimiter":
+			out.Values[i] = ec._ContentTaxonomySettings_tagPathDelimiter(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
+		case "normalizations":
+			out.Values[i] = ec._ContentTaxonomySettings_normalizations(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8073,6 +12185,13 @@ func (ec *executionContext) _HTTPClientSettings(ctx context.Context, sel ast.Sel
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
+		case "blockPrivateNetworks":
+			out.Values[i] = ec._HTTPClientSettings_blockPrivateNetworks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "allowedNetworks":
+			out.Values[i] = ec._HTTPClientSettings_allowedNetworks(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8190,6 +12309,47 @@ func (ec *executionContext) _HiearchicalTaxonomy(ctx context.Context, sel ast.Se
 	return out
 }
 
+var linkDomainPolicyImplementors = []string{"LinkDomainPolicy"}
+
+func (ec *executionContext) _LinkDomainPolicy(ctx context.Context, sel ast.SelectionSet, obj *model.LinkDomainPolicy) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, linkDomainPolicyImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LinkDomainPolicy")
+		case "domains":
+			out.Values[i] = ec._LinkDomainPolicy_domains(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "traverseLinks":
+			out.Values[i] = ec._LinkDomainPolicy_traverseLinks(ctx, field, obj)
+		case "scoreLinks":
+			out.Values[i] = ec._LinkDomainPolicy_scoreLinks(ctx, field, obj)
+		case "followRedirectsInLinkDestinationHTMLContent":
+			out.Values[i] = ec._LinkDomainPolicy_followRedirectsInLinkDestinationHTMLContent(ctx, field, obj)
+		case "parseMetaDataInLinkDestinationHTMLContent":
+			out.Values[i] = ec._LinkDomainPolicy_parseMetaDataInLinkDestinationHTMLContent(ctx, field, obj)
+		case "downloadLinkDestinationAttachments":
+			out.Values[i] = ec._LinkDomainPolicy_downloadLinkDestinationAttachments(ctx, field, obj)
+		case "userAgent":
+			out.Values[i] = ec._LinkDomainPolicy_userAgent(ctx, field, obj)
+		case "timeout":
+			out.Values[i] = ec._LinkDomainPolicy_timeout(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
 var linkLifecyleSettingsImplementors = []string{"LinkLifecyleSettings", "PersistentSettings"}
 
 func (ec *executionContext) _LinkLifecyleSettings(ctx context.Context, sel ast.SelectionSet, obj *model.LinkLifecyleSettings) graphql.Marshaler {
@@ -8218,20 +12378,173 @@ func (ec *executionContext) _LinkLifecyleSettings(ctx context.Context, sel ast.S
 			}
 		case "ignoreURLsRegExprs":
 			out.Values[i] = ec._LinkLifecyleSettings_ignoreURLsRegExprs(ctx, field, obj)
+		case "allowURLsRegExprs":
+			out.Values[i] = ec._LinkLifecyleSettings_allowURLsRegExprs(ctx, field, obj)
 		case "removeParamsFromURLsRegEx":
 			out.Values[i] = ec._LinkLifecyleSettings_removeParamsFromURLsRegEx(ctx, field, obj)
+		case "rewriteURLRules":
+			out.Values[i] = ec._LinkLifecyleSettings_rewriteURLRules(ctx, field, obj)
 		case "followRedirectsInLinkDestinationHTMLContent":
 			out.Values[i] = ec._LinkLifecyleSettings_followRedirectsInLinkDestinationHTMLContent(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "parseMetaDataInLinkDestinationHTMLContent":
-			out.Values[i] = ec._LinkLifecyleSettings_parseMetaDataInLinkDestinationHTMLContent(ctx, field, obj)
+		case "parseMetaDataInLinkDestinationHTMLContent":
+			out.Values[i] = ec._LinkLifecyleSettings_parseMetaDataInLinkDestinationHTMLContent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "downloadLinkDestinationAttachments":
+			out.Values[i] = ec._LinkLifecyleSettings_downloadLinkDestinationAttachments(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "maxConcurrentHarvests":
+			out.Values[i] = ec._LinkLifecyleSettings_maxConcurrentHarvests(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "maxConcurrentHarvestsPerHost":
+			out.Values[i] = ec._LinkLifecyleSettings_maxConcurrentHarvestsPerHost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "retry":
+			out.Values[i] = ec._LinkLifecyleSettings_retry(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "robots":
+			out.Values[i] = ec._LinkLifecyleSettings_robots(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "domainPolicies":
+			out.Values[i] = ec._LinkLifecyleSettings_domainPolicies(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var linkRedirectImplementors = []string{"LinkRedirect"}
+
+func (ec *executionContext) _LinkRedirect(ctx context.Context, sel ast.SelectionSet, obj *model.LinkRedirect) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, linkRedirectImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LinkRedirect")
+		case "url":
+			out.Values[i] = ec._LinkRedirect_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "statusCode":
+			out.Values[i] = ec._LinkRedirect_statusCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "redirect":
+			out.Values[i] = ec._LinkRedirect_redirect(ctx, field, obj)
+		case "duration":
+			out.Values[i] = ec._LinkRedirect_duration(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "error":
+			out.Values[i] = ec._LinkRedirect_error(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var linkRetrySettingsImplementors = []string{"LinkRetrySettings"}
+
+func (ec *executionContext) _LinkRetrySettings(ctx context.Context, sel ast.SelectionSet, obj *model.LinkRetrySettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, linkRetrySettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LinkRetrySettings")
+		case "maxAttempts":
+			out.Values[i] = ec._LinkRetrySettings_maxAttempts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "initialBackoff":
+			out.Values[i] = ec._LinkRetrySettings_initialBackoff(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "maxBackoff":
+			out.Values[i] = ec._LinkRetrySettings_maxBackoff(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "retryStatusCodes":
+			out.Values[i] = ec._LinkRetrySettings_retryStatusCodes(ctx, field, obj)
+		case "circuitBreakerFailures":
+			out.Values[i] = ec._LinkRetrySettings_circuitBreakerFailures(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "circuitBreakerCooldown":
+			out.Values[i] = ec._LinkRetrySettings_circuitBreakerCooldown(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var linkRobotsSettingsImplementors = []string{"LinkRobotsSettings"}
+
+func (ec *executionContext) _LinkRobotsSettings(ctx context.Context, sel ast.SelectionSet, obj *model.LinkRobotsSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, linkRobotsSettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LinkRobotsSettings")
+		case "honorRobotsTxt":
+			out.Values[i] = ec._LinkRobotsSettings_honorRobotsTxt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "cacheDuration":
+			out.Values[i] = ec._LinkRobotsSettings_cacheDuration(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "downloadLinkDestinationAttachments":
-			out.Values[i] = ec._LinkLifecyleSettings_downloadLinkDestinationAttachments(ctx, field, obj)
+		case "maxCrawlDelay":
+			out.Values[i] = ec._LinkRobotsSettings_maxCrawlDelay(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
@@ -8393,6 +12706,36 @@ func (ec *executionContext) _MarkdownGeneratorSettings(ctx context.Context, sel
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
+		case "attachmentsPath":
+			out.Values[i] = ec._MarkdownGeneratorSettings_attachmentsPath(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "attachmentsURLRel":
+			out.Values[i] = ec._MarkdownGeneratorSettings_attachmentsURLRel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "syncStatePath":
+			out.Values[i] = ec._MarkdownGeneratorSettings_syncStatePath(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "deletedContentPolicy":
+			out.Values[i] = ec._MarkdownGeneratorSettings_deletedContentPolicy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "archivePath":
+			out.Values[i] = ec._MarkdownGeneratorSettings_archivePath(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "redirectChainInFrontMatter":
+			out.Values[i] = ec._MarkdownGeneratorSettings_redirectChainInFrontMatter(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8565,6 +12908,17 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				res = ec._Query_settings(ctx, field)
 				return res
 			})
+		case "sources":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_sources(ctx, field)
+				return res
+			})
 		case "source":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -8602,6 +12956,35 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
+var registeredSourceImplementors = []string{"RegisteredSource"}
+
+func (ec *executionContext) _RegisteredSource(ctx context.Context, sel ast.SelectionSet, obj *model.RegisteredSource) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, registeredSourceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RegisteredSource")
+		case "name":
+			out.Values[i] = ec._RegisteredSource_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "urlPattern":
+			out.Values[i] = ec._RegisteredSource_urlPattern(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
 var repositoriesImplementors = []string{"Repositories", "PersistentSettings"}
 
 func (ec *executionContext) _Repositories(ctx context.Context, sel ast.SelectionSet, obj *model.Repositories) graphql.Marshaler {
@@ -8690,6 +13073,100 @@ func (ec *executionContext) _SettingsStore(ctx context.Context, sel ast.Selectio
 	return out
 }
 
+var sourceSettingsImplementors = []string{"SourceSettings", "PersistentSettings"}
+
+func (ec *executionContext) _SourceSettings(ctx context.Context, sel ast.SelectionSet, obj *model.SourceSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, sourceSettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SourceSettings")
+		case "store":
+			out.Values[i] = ec._SourceSettings_store(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "outlinePolicy":
+			out.Values[i] = ec._SourceSettings_outlinePolicy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "columns":
+			out.Values[i] = ec._SourceSettings_columns(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "localFilesRootPath":
+			out.Values[i] = ec._SourceSettings_localFilesRootPath(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "allowStdin":
+			out.Values[i] = ec._SourceSettings_allowStdin(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var tabularColumnMappingImplementors = []string{"TabularColumnMapping"}
+
+func (ec *executionContext) _TabularColumnMapping(ctx context.Context, sel ast.SelectionSet, obj *model.TabularColumnMapping) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, tabularColumnMappingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TabularColumnMapping")
+		case "url":
+			out.Values[i] = ec._TabularColumnMapping_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "title":
+			out.Values[i] = ec._TabularColumnMapping_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "tags":
+			out.Values[i] = ec._TabularColumnMapping_tags(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "tagsDelimiter":
+			out.Values[i] = ec._TabularColumnMapping_tagsDelimiter(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "date":
+			out.Values[i] = ec._TabularColumnMapping_date(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
 var taxonNodeImplementors = []string{"TaxonNode"}
 
 func (ec *executionContext) _TaxonNode(ctx context.Context, sel ast.SelectionSet, obj *model.TaxonNode) graphql.Marshaler {
@@ -8719,6 +13196,79 @@ func (ec *executionContext) _TaxonNode(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
+var taxonSynonymsImplementors = []string{"TaxonSynonyms"}
+
+func (ec *executionContext) _TaxonSynonyms(ctx context.Context, sel ast.SelectionSet, obj *model.TaxonSynonyms) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, taxonSynonymsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TaxonSynonyms")
+		case "preferred":
+			out.Values[i] = ec._TaxonSynonyms_preferred(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "synonyms":
+			out.Values[i] = ec._TaxonSynonyms_synonyms(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var taxonomyNormalizationImplementors = []string{"TaxonomyNormalization"}
+
+func (ec *executionContext) _TaxonomyNormalization(ctx context.Context, sel ast.SelectionSet, obj *model.TaxonomyNormalization) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, taxonomyNormalizationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TaxonomyNormalization")
+		case "taxonomy":
+			out.Values[i] = ec._TaxonomyNormalization_taxonomy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "foldCase":
+			out.Values[i] = ec._TaxonomyNormalization_foldCase(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "slug":
+			out.Values[i] = ec._TaxonomyNormalization_slug(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "synonyms":
+			out.Values[i] = ec._TaxonomyNormalization_synonyms(ctx, field, obj)
+		case "warnUnknownTaxa":
+			out.Values[i] = ec._TaxonomyNormalization_warnUnknownTaxa(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
 var tempFileRepositoryImplementors = []string{"TempFileRepository", "Repository"}
 
 func (ec *executionContext) _TempFileRepository(ctx context.Context, sel ast.SelectionSet, obj *model.TempFileRepository) graphql.Marshaler {
@@ -8788,6 +13338,79 @@ func (ec *executionContext) _TextProperty(ctx context.Context, sel ast.Selection
 	return out
 }
 
+var uRLRewriteImplementors = []string{"URLRewrite"}
+
+func (ec *executionContext) _URLRewrite(ctx context.Context, sel ast.SelectionSet, obj *model.URLRewrite) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, uRLRewriteImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("URLRewrite")
+		case "rule":
+			out.Values[i] = ec._URLRewrite_rule(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "from":
+			out.Values[i] = ec._URLRewrite_from(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "to":
+			out.Values[i] = ec._URLRewrite_to(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var uRLRewriteRuleImplementors = []string{"URLRewriteRule"}
+
+func (ec *executionContext) _URLRewriteRule(ctx context.Context, sel ast.SelectionSet, obj *model.URLRewriteRule) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, uRLRewriteRuleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("URLRewriteRule")
+		case "name":
+			out.Values[i] = ec._URLRewriteRule_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "type":
+			out.Values[i] = ec._URLRewriteRule_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "match":
+			out.Values[i] = ec._URLRewriteRule_match(ctx, field, obj)
+		case "replacement":
+			out.Values[i] = ec._URLRewriteRule_replacement(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
 var __DirectiveImplementors = []string{"__Directive"}
 
 func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
@@ -9123,6 +13746,19 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return graphql.MarshalBoolean(v)
 }
 
+func (ec *executionContext) marshalNCategorization2githubᚗcomᚋlectioᚋgraphᚋmodelᚐCategorization(ctx context.Context, sel ast.SelectionSet, v model.Categorization) graphql.Marshaler {
+	return ec._Categorization(ctx, sel, &v)
+}
+
+func (ec *executionContext) unmarshalNContentBodyExtractPolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentBodyExtractPolicy(ctx context.Context, v interface{}) (model.ContentBodyExtractPolicy, error) {
+	var res model.ContentBodyExtractPolicy
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNContentBodyExtractPolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentBodyExtractPolicy(ctx context.Context, sel ast.SelectionSet, v model.ContentBodyExtractPolicy) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNContentBodySettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentBodySettings(ctx context.Context, sel ast.SelectionSet, v model.ContentBodySettings) graphql.Marshaler {
 	return ec._ContentBodySettings(ctx, sel, &v)
 }
@@ -9136,6 +13772,27 @@ func (ec *executionContext) marshalNContentBodyText2githubᚗcomᚋlectioᚋgrap
 	return v
 }
 
+func (ec *executionContext) marshalNContentCategorizationRule2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentCategorizationRule(ctx context.Context, sel ast.SelectionSet, v model.ContentCategorizationRule) graphql.Marshaler {
+	return ec._ContentCategorizationRule(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNContentKeywordsSettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentKeywordsSettings(ctx context.Context, sel ast.SelectionSet, v model.ContentKeywordsSettings) graphql.Marshaler {
+	return ec._ContentKeywordsSettings(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNContentMetadataSettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentMetadataSettings(ctx context.Context, sel ast.SelectionSet, v model.ContentMetadataSettings) graphql.Marshaler {
+	return ec._ContentMetadataSettings(ctx, sel, &v)
+}
+
+func (ec *executionContext) unmarshalNContentSentenceSplitter2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentSentenceSplitter(ctx context.Context, v interface{}) (model.ContentSentenceSplitter, error) {
+	var res model.ContentSentenceSplitter
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNContentSentenceSplitter2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentSentenceSplitter(ctx context.Context, sel ast.SelectionSet, v model.ContentSentenceSplitter) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNContentSummaryPolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentSummaryPolicy(ctx context.Context, v interface{}) (model.ContentSummaryPolicy, error) {
 	var res model.ContentSummaryPolicy
 	return res, res.UnmarshalGQL(v)
@@ -9158,6 +13815,10 @@ func (ec *executionContext) marshalNContentSummaryText2githubᚗcomᚋlectioᚋg
 	return v
 }
 
+func (ec *executionContext) marshalNContentTaxonomySettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentTaxonomySettings(ctx context.Context, sel ast.SelectionSet, v model.ContentTaxonomySettings) graphql.Marshaler {
+	return ec._ContentTaxonomySettings(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNContentTitleSettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentTitleSettings(ctx context.Context, sel ast.SelectionSet, v model.ContentTitleSettings) graphql.Marshaler {
 	return ec._ContentTitleSettings(ctx, sel, &v)
 }
@@ -9185,7 +13846,16 @@ func (ec *executionContext) unmarshalNDateTime2githubᚗcomᚋlectioᚋgraphᚋm
 	return res, res.UnmarshalGQL(v)
 }
 
-func (ec *executionContext) marshalNDateTime2githubᚗcomᚋlectioᚋgraphᚋmodelᚐDateTime(ctx context.Context, sel ast.SelectionSet, v model.DateTime) graphql.Marshaler {
+func (ec *executionContext) marshalNDateTime2githubᚗcomᚋlectioᚋgraphᚋmodelᚐDateTime(ctx context.Context, sel ast.SelectionSet, v model.DateTime) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNDeletedContentPolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐDeletedContentPolicy(ctx context.Context, v interface{}) (model.DeletedContentPolicy, error) {
+	var res model.DeletedContentPolicy
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNDeletedContentPolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐDeletedContentPolicy(ctx context.Context, sel ast.SelectionSet, v model.DeletedContentPolicy) graphql.Marshaler {
 	return v
 }
 
@@ -9238,6 +13908,49 @@ func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.Selecti
 	return graphql.MarshalInt(v)
 }
 
+func (ec *executionContext) marshalNLinkDomainPolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkDomainPolicy(ctx context.Context, sel ast.SelectionSet, v model.LinkDomainPolicy) graphql.Marshaler {
+	return ec._LinkDomainPolicy(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNLinkRedirect2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkRedirect(ctx context.Context, sel ast.SelectionSet, v model.LinkRedirect) graphql.Marshaler {
+	return ec._LinkRedirect(ctx, sel, &v)
+}
+
+func (ec *executionContext) unmarshalNLinkRedirectDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx context.Context, v interface{}) (model.TimeoutDuration, error) {
+	var res model.TimeoutDuration
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNLinkRedirectDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx context.Context, sel ast.SelectionSet, v model.TimeoutDuration) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNLinkRetryDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx context.Context, v interface{}) (model.TimeoutDuration, error) {
+	var res model.TimeoutDuration
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNLinkRetryDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx context.Context, sel ast.SelectionSet, v model.TimeoutDuration) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNLinkRetrySettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkRetrySettings(ctx context.Context, sel ast.SelectionSet, v model.LinkRetrySettings) graphql.Marshaler {
+	return ec._LinkRetrySettings(ctx, sel, &v)
+}
+
+func (ec *executionContext) unmarshalNLinkRobotsDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx context.Context, v interface{}) (model.TimeoutDuration, error) {
+	var res model.TimeoutDuration
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNLinkRobotsDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx context.Context, sel ast.SelectionSet, v model.TimeoutDuration) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNLinkRobotsSettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkRobotsSettings(ctx context.Context, sel ast.SelectionSet, v model.LinkRobotsSettings) graphql.Marshaler {
+	return ec._LinkRobotsSettings(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNLinkScorer2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkScorer(ctx context.Context, sel ast.SelectionSet, v model.LinkScorer) graphql.Marshaler {
 	return ec._LinkScorer(ctx, sel, &v)
 }
@@ -9377,6 +14090,10 @@ func (ec *executionContext) marshalNPropertyName2githubᚗcomᚋlectioᚋgraph
 	return graphql.MarshalString(string(v))
 }
 
+func (ec *executionContext) marshalNRegisteredSource2githubᚗcomᚋlectioᚋgraphᚋmodelᚐRegisteredSource(ctx context.Context, sel ast.SelectionSet, v model.RegisteredSource) graphql.Marshaler {
+	return ec._RegisteredSource(ctx, sel, &v)
+}
+
 func (ec *executionContext) unmarshalNRelativeDirectoryPath2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -9442,6 +14159,15 @@ func (ec *executionContext) marshalNSettingsStoreName2githubᚗcomᚋlectioᚋgr
 	return v
 }
 
+func (ec *executionContext) unmarshalNSourceOutlinePolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSourceOutlinePolicy(ctx context.Context, v interface{}) (model.SourceOutlinePolicy, error) {
+	var res model.SourceOutlinePolicy
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNSourceOutlinePolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐSourceOutlinePolicy(ctx context.Context, sel ast.SelectionSet, v model.SourceOutlinePolicy) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -9450,6 +14176,39 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return graphql.MarshalString(v)
 }
 
+func (ec *executionContext) unmarshalNString2ᚕstring(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstring(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNTabularColumnMapping2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTabularColumnMapping(ctx context.Context, sel ast.SelectionSet, v model.TabularColumnMapping) graphql.Marshaler {
+	return ec._TabularColumnMapping(ctx, sel, &v)
+}
+
 func (ec *executionContext) unmarshalNTaxonName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonName(ctx context.Context, v interface{}) (model.TaxonName, error) {
 	tmp, err := graphql.UnmarshalString(v)
 	return model.TaxonName(tmp), err
@@ -9529,6 +14288,10 @@ func (ec *executionContext) marshalNTaxonNode2ᚕgithubᚗcomᚋlectioᚋgraph
 	return ret
 }
 
+func (ec *executionContext) marshalNTaxonSynonyms2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonSynonyms(ctx context.Context, sel ast.SelectionSet, v model.TaxonSynonyms) graphql.Marshaler {
+	return ec._TaxonSynonyms(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNTaxonomy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonomy(ctx context.Context, sel ast.SelectionSet, v model.Taxonomy) graphql.Marshaler {
 	return ec._Taxonomy(ctx, sel, &v)
 }
@@ -9579,6 +14342,10 @@ func (ec *executionContext) marshalNTaxonomyName2githubᚗcomᚋlectioᚋgraph
 	return graphql.MarshalString(string(v))
 }
 
+func (ec *executionContext) marshalNTaxonomyNormalization2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonomyNormalization(ctx context.Context, sel ast.SelectionSet, v model.TaxonomyNormalization) graphql.Marshaler {
+	return ec._TaxonomyNormalization(ctx, sel, &v)
+}
+
 func (ec *executionContext) unmarshalNTempFileRepositoryPrefix2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -9587,6 +14354,23 @@ func (ec *executionContext) marshalNTempFileRepositoryPrefix2string(ctx context.
 	return graphql.MarshalString(v)
 }
 
+func (ec *executionContext) marshalNURLRewrite2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLRewrite(ctx context.Context, sel ast.SelectionSet, v model.URLRewrite) graphql.Marshaler {
+	return ec._URLRewrite(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNURLRewriteRule2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLRewriteRule(ctx context.Context, sel ast.SelectionSet, v model.URLRewriteRule) graphql.Marshaler {
+	return ec._URLRewriteRule(ctx, sel, &v)
+}
+
+func (ec *executionContext) unmarshalNURLRewriteRuleType2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLRewriteRuleType(ctx context.Context, v interface{}) (model.URLRewriteRuleType, error) {
+	var res model.URLRewriteRuleType
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNURLRewriteRuleType2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLRewriteRuleType(ctx context.Context, sel ast.SelectionSet, v model.URLRewriteRuleType) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNURLText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx context.Context, v interface{}) (model.URLText, error) {
 	var res model.URLText
 	return res, res.UnmarshalGQL(v)
@@ -9653,19 +14437,207 @@ func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstring(ctx context.
 		} else {
 			vSlice = []interface{}{v}
 		}
-	}
-	var err error
-	res := make([]string, len(vSlice))
-	for i := range vSlice {
-		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalN__DirectiveLocation2ᚕstring(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
+	return ec.___EnumValue(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
+	return ec.___Field(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
+	return ec.___InputValue(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
+	return ec.___Type(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec.___Type(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
+	return graphql.UnmarshalString(v)
+}
+
+func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	return graphql.MarshalString(v)
+}
+
+func (ec *executionContext) marshalOActivity2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐActivity(ctx context.Context, sel ast.SelectionSet, v []model.Activity) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNActivity2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivity(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	return res, nil
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) marshalN__DirectiveLocation2ᚕstring(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+func (ec *executionContext) marshalOActivityError2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityError(ctx context.Context, sel ast.SelectionSet, v []model.ActivityError) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9689,7 +14661,7 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstring(ctx context.Co
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
+			ret[i] = ec.marshalNActivityError2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityError(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9702,19 +14674,10 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstring(ctx context.Co
 	return ret
 }
 
-func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
-	return ec.___EnumValue(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
-	return ec.___Field(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
-	return ec.___InputValue(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+func (ec *executionContext) marshalOActivityWarning2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityWarning(ctx context.Context, sel ast.SelectionSet, v []model.ActivityWarning) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9738,7 +14701,7 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+			ret[i] = ec.marshalNActivityWarning2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityWarning(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9751,11 +14714,10 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
-	return ec.___Type(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalOBookmark2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐBookmark(ctx context.Context, sel ast.SelectionSet, v []model.Bookmark) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9779,7 +14741,7 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+			ret[i] = ec.marshalNBookmark2githubᚗcomᚋlectioᚋgraphᚋmodelᚐBookmark(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9792,25 +14754,41 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalOBookmarks2githubᚗcomᚋlectioᚋgraphᚋmodelᚐBookmarks(ctx context.Context, sel ast.SelectionSet, v model.Bookmarks) graphql.Marshaler {
+	return ec._Bookmarks(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOBookmarks2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐBookmarks(ctx context.Context, sel ast.SelectionSet, v *model.Bookmarks) graphql.Marshaler {
 	if v == nil {
-		if !ec.HasError(graphql.GetResolverContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	return ec.___Type(ctx, sel, v)
+	return ec._Bookmarks(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
-	return graphql.UnmarshalString(v)
+func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	return graphql.UnmarshalBoolean(v)
 }
 
-func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	return graphql.MarshalString(v)
+func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	return graphql.MarshalBoolean(v)
 }
 
-func (ec *executionContext) marshalOActivity2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐActivity(ctx context.Context, sel ast.SelectionSet, v []model.Activity) graphql.Marshaler {
+func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v interface{}) (*bool, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOBoolean2bool(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec.marshalOBoolean2bool(ctx, sel, *v)
+}
+
+func (ec *executionContext) marshalOCategorization2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐCategorization(ctx context.Context, sel ast.SelectionSet, v []model.Categorization) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -9837,7 +14815,7 @@ func (ec *executionContext) marshalOActivity2ᚕgithubᚗcomᚋlectioᚋgraphᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNActivity2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivity(ctx, sel, v[i])
+			ret[i] = ec.marshalNCategorization2githubᚗcomᚋlectioᚋgraphᚋmodelᚐCategorization(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9850,7 +14828,7 @@ func (ec *executionContext) marshalOActivity2ᚕgithubᚗcomᚋlectioᚋgraphᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalOActivityError2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityError(ctx context.Context, sel ast.SelectionSet, v []model.ActivityError) graphql.Marshaler {
+func (ec *executionContext) marshalOContentCategorizationRule2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐContentCategorizationRule(ctx context.Context, sel ast.SelectionSet, v []model.ContentCategorizationRule) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -9877,7 +14855,7 @@ func (ec *executionContext) marshalOActivityError2ᚕgithubᚗcomᚋlectioᚋgra
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNActivityError2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityError(ctx, sel, v[i])
+			ret[i] = ec.marshalNContentCategorizationRule2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentCategorizationRule(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9890,7 +14868,67 @@ func (ec *executionContext) marshalOActivityError2ᚕgithubᚗcomᚋlectioᚋgra
 	return ret
 }
 
-func (ec *executionContext) marshalOActivityWarning2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityWarning(ctx context.Context, sel ast.SelectionSet, v []model.ActivityWarning) graphql.Marshaler {
+func (ec *executionContext) marshalOContentSource2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentSource(ctx context.Context, sel ast.SelectionSet, v model.ContentSource) graphql.Marshaler {
+	return ec._ContentSource(ctx, sel, &v)
+}
+
+func (ec *executionContext) unmarshalOHTTPClientTimeoutDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx context.Context, v interface{}) (model.TimeoutDuration, error) {
+	var res model.TimeoutDuration
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalOHTTPClientTimeoutDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx context.Context, sel ast.SelectionSet, v model.TimeoutDuration) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalOHTTPClientTimeoutDuration2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx context.Context, v interface{}) (*model.TimeoutDuration, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOHTTPClientTimeoutDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOHTTPClientTimeoutDuration2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx context.Context, sel ast.SelectionSet, v *model.TimeoutDuration) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOInt2ᚕint(ctx context.Context, v interface{}) ([]int, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]int, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNInt2int(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOInt2ᚕint(ctx context.Context, sel ast.SelectionSet, v []int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNInt2int(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalOLinkDomainPolicy2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkDomainPolicy(ctx context.Context, sel ast.SelectionSet, v []model.LinkDomainPolicy) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -9917,7 +14955,7 @@ func (ec *executionContext) marshalOActivityWarning2ᚕgithubᚗcomᚋlectioᚋg
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNActivityWarning2githubᚗcomᚋlectioᚋgraphᚋmodelᚐActivityWarning(ctx, sel, v[i])
+			ret[i] = ec.marshalNLinkDomainPolicy2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkDomainPolicy(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9930,7 +14968,7 @@ func (ec *executionContext) marshalOActivityWarning2ᚕgithubᚗcomᚋlectioᚋg
 	return ret
 }
 
-func (ec *executionContext) marshalOBookmark2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐBookmark(ctx context.Context, sel ast.SelectionSet, v []model.Bookmark) graphql.Marshaler {
+func (ec *executionContext) marshalOLinkRedirect2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkRedirect(ctx context.Context, sel ast.SelectionSet, v []model.LinkRedirect) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -9957,7 +14995,7 @@ func (ec *executionContext) marshalOBookmark2ᚕgithubᚗcomᚋlectioᚋgraphᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNBookmark2githubᚗcomᚋlectioᚋgraphᚋmodelᚐBookmark(ctx, sel, v[i])
+			ret[i] = ec.marshalNLinkRedirect2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkRedirect(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9970,48 +15008,45 @@ func (ec *executionContext) marshalOBookmark2ᚕgithubᚗcomᚋlectioᚋgraphᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalOBookmarks2githubᚗcomᚋlectioᚋgraphᚋmodelᚐBookmarks(ctx context.Context, sel ast.SelectionSet, v model.Bookmarks) graphql.Marshaler {
-	return ec._Bookmarks(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalOBookmarks2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐBookmarks(ctx context.Context, sel ast.SelectionSet, v *model.Bookmarks) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	return ec._Bookmarks(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	return graphql.UnmarshalBoolean(v)
+func (ec *executionContext) unmarshalOLinkRedirectType2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkRedirectType(ctx context.Context, v interface{}) (model.LinkRedirectType, error) {
+	var res model.LinkRedirectType
+	return res, res.UnmarshalGQL(v)
 }
 
-func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	return graphql.MarshalBoolean(v)
+func (ec *executionContext) marshalOLinkRedirectType2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkRedirectType(ctx context.Context, sel ast.SelectionSet, v model.LinkRedirectType) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v interface{}) (*bool, error) {
+func (ec *executionContext) unmarshalOLinkRedirectType2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkRedirectType(ctx context.Context, v interface{}) (*model.LinkRedirectType, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := ec.unmarshalOBoolean2bool(ctx, v)
+	res, err := ec.unmarshalOLinkRedirectType2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkRedirectType(ctx, v)
 	return &res, err
 }
 
-func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+func (ec *executionContext) marshalOLinkRedirectType2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkRedirectType(ctx context.Context, sel ast.SelectionSet, v *model.LinkRedirectType) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec.marshalOBoolean2bool(ctx, sel, *v)
-}
-
-func (ec *executionContext) marshalOContentSource2githubᚗcomᚋlectioᚋgraphᚋmodelᚐContentSource(ctx context.Context, sel ast.SelectionSet, v model.ContentSource) graphql.Marshaler {
-	return ec._ContentSource(ctx, sel, &v)
+	return v
 }
 
 func (ec *executionContext) marshalOLinkScores2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkScores(ctx context.Context, sel ast.SelectionSet, v model.LinkScores) graphql.Marshaler {
 	return ec._LinkScores(ctx, sel, &v)
 }
 
+func (ec *executionContext) marshalOLinkScoresLifecycleSettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkScoresLifecycleSettings(ctx context.Context, sel ast.SelectionSet, v model.LinkScoresLifecycleSettings) graphql.Marshaler {
+	return ec._LinkScoresLifecycleSettings(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOLinkScoresLifecycleSettings2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐLinkScoresLifecycleSettings(ctx context.Context, sel ast.SelectionSet, v *model.LinkScoresLifecycleSettings) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._LinkScoresLifecycleSettings(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOPersistentSettings2githubᚗcomᚋlectioᚋgraphᚋmodelᚐPersistentSettings(ctx context.Context, sel ast.SelectionSet, v model.PersistentSettings) graphql.Marshaler {
 	return ec._PersistentSettings(ctx, sel, &v)
 }
@@ -10076,18 +15111,106 @@ func (ec *executionContext) unmarshalOPipelineParamInput2ᚕgithubᚗcomᚋlecti
 	return res, nil
 }
 
-func (ec *executionContext) marshalOProperties2githubᚗcomᚋlectioᚋgraphᚋmodelᚐProperties(ctx context.Context, sel ast.SelectionSet, v model.Properties) graphql.Marshaler {
-	return ec._Properties(ctx, sel, &v)
+func (ec *executionContext) unmarshalOPipelineTimeoutDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx context.Context, v interface{}) (model.TimeoutDuration, error) {
+	var res model.TimeoutDuration
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalOPipelineTimeoutDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx context.Context, sel ast.SelectionSet, v model.TimeoutDuration) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalOPipelineTimeoutDuration2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx context.Context, v interface{}) (*model.TimeoutDuration, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOPipelineTimeoutDuration2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOPipelineTimeoutDuration2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTimeoutDuration(ctx context.Context, sel ast.SelectionSet, v *model.TimeoutDuration) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOProperties2githubᚗcomᚋlectioᚋgraphᚋmodelᚐProperties(ctx context.Context, sel ast.SelectionSet, v model.Properties) graphql.Marshaler {
+	return ec._Properties(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOProperties2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐProperties(ctx context.Context, sel ast.SelectionSet, v *model.Properties) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Properties(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOProperty2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐProperty(ctx context.Context, sel ast.SelectionSet, v []model.Property) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNProperty2githubᚗcomᚋlectioᚋgraphᚋmodelᚐProperty(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) unmarshalOPropertyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐPropertyName(ctx context.Context, v interface{}) (model.PropertyName, error) {
+	tmp, err := graphql.UnmarshalString(v)
+	return model.PropertyName(tmp), err
 }
 
-func (ec *executionContext) marshalOProperties2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐProperties(ctx context.Context, sel ast.SelectionSet, v *model.Properties) graphql.Marshaler {
+func (ec *executionContext) marshalOPropertyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐPropertyName(ctx context.Context, sel ast.SelectionSet, v model.PropertyName) graphql.Marshaler {
+	return graphql.MarshalString(string(v))
+}
+
+func (ec *executionContext) unmarshalOPropertyName2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐPropertyName(ctx context.Context, v interface{}) (*model.PropertyName, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOPropertyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐPropertyName(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOPropertyName2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐPropertyName(ctx context.Context, sel ast.SelectionSet, v *model.PropertyName) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._Properties(ctx, sel, v)
+	return ec.marshalOPropertyName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐPropertyName(ctx, sel, *v)
 }
 
-func (ec *executionContext) marshalOProperty2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐProperty(ctx context.Context, sel ast.SelectionSet, v []model.Property) graphql.Marshaler {
+func (ec *executionContext) marshalORegisteredSource2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐRegisteredSource(ctx context.Context, sel ast.SelectionSet, v []model.RegisteredSource) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -10114,7 +15237,7 @@ func (ec *executionContext) marshalOProperty2ᚕgithubᚗcomᚋlectioᚋgraphᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNProperty2githubᚗcomᚋlectioᚋgraphᚋmodelᚐProperty(ctx, sel, v[i])
+			ret[i] = ec.marshalNRegisteredSource2githubᚗcomᚋlectioᚋgraphᚋmodelᚐRegisteredSource(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -10231,6 +15354,38 @@ func (ec *executionContext) marshalOString2string(ctx context.Context, sel ast.S
 	return graphql.MarshalString(v)
 }
 
+func (ec *executionContext) unmarshalOString2ᚕstring(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOString2ᚕstring(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -10255,6 +15410,38 @@ func (ec *executionContext) marshalOTaxonName2githubᚗcomᚋlectioᚋgraphᚋmo
 	return graphql.MarshalString(string(v))
 }
 
+func (ec *executionContext) unmarshalOTaxonName2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonName(ctx context.Context, v interface{}) ([]model.TaxonName, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]model.TaxonName, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNTaxonName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonName(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOTaxonName2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonName(ctx context.Context, sel ast.SelectionSet, v []model.TaxonName) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNTaxonName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonName(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOTaxonName2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonName(ctx context.Context, v interface{}) (*model.TaxonName, error) {
 	if v == nil {
 		return nil, nil
@@ -10270,6 +15457,86 @@ func (ec *executionContext) marshalOTaxonName2ᚖgithubᚗcomᚋlectioᚋgraph
 	return ec.marshalOTaxonName2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonName(ctx, sel, *v)
 }
 
+func (ec *executionContext) marshalOTaxonSynonyms2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonSynonyms(ctx context.Context, sel ast.SelectionSet, v []model.TaxonSynonyms) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTaxonSynonyms2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonSynonyms(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOTaxonomyNormalization2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonomyNormalization(ctx context.Context, sel ast.SelectionSet, v []model.TaxonomyNormalization) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTaxonomyNormalization2githubᚗcomᚋlectioᚋgraphᚋmodelᚐTaxonomyNormalization(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
 func (ec *executionContext) unmarshalOURL2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURL(ctx context.Context, v interface{}) (model.URL, error) {
 	var res model.URL
 	return res, res.UnmarshalGQL(v)
@@ -10294,6 +15561,118 @@ func (ec *executionContext) marshalOURL2ᚖgithubᚗcomᚋlectioᚋgraphᚋmodel
 	return v
 }
 
+func (ec *executionContext) marshalOURLRewrite2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐURLRewrite(ctx context.Context, sel ast.SelectionSet, v []model.URLRewrite) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNURLRewrite2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLRewrite(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOURLRewriteRule2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐURLRewriteRule(ctx context.Context, sel ast.SelectionSet, v []model.URLRewriteRule) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNURLRewriteRule2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLRewriteRule(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) unmarshalOURLText2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx context.Context, v interface{}) ([]model.URLText, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]model.URLText, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNURLText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOURLText2ᚕgithubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx context.Context, sel ast.SelectionSet, v []model.URLText) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNURLText2githubᚗcomᚋlectioᚋgraphᚋmodelᚐURLText(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -42,6 +42,10 @@ func (r *queryResolver) Settings(ctx context.Context, settings model.SettingsPat
 	return nil, fmt.Errorf("Not implemented yet")
 }
 
+func (r *queryResolver) Sources(ctx context.Context) ([]model.RegisteredSource, error) {
+	return source.DefaultRegistry.RegisteredSources(), nil
+}
+
 func (r *queryResolver) Source(ctx context.Context, urlText model.URLText) (model.ContentSource, error) {
 	source, _, srcErr := source.DetectFromURLText(urlText)
 	return source, srcErr
@@ -53,7 +57,7 @@ func (r *queryResolver) Bookmarks(ctx context.Context, sourceURL model.URLText,
 		return nil, srcErr
 	}
 
-	params, paramsErr := source.NewLinksAPIHandlerParams(r.config, apiSource, settings)
+	params, paramsErr := source.NewLinksAPIHandlerParams(ctx, r.config, apiSource, settings)
 	if paramsErr != nil {
 		return nil, paramsErr
 	}
@@ -72,7 +76,7 @@ func (r *mutationResolver) ExecuteBookmarksToMarkdownPipeline(ctx context.Contex
 	if perr != nil {
 		return nil, perr
 	}
-	result, err := p.Execute()
+	result, err := p.Execute(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,129 @@
+package source
+
+import (
+	"fmt"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/lectio/resource"
+	"github.com/spf13/afero"
+
+	"github.com/lectio/graph/model"
+)
+
+// RepositoryAttachments saves the attachments downloaded while traversing links into a directory of a repository,
+// each file is named with the content-addressable hash of its URL
+type RepositoryAttachments struct {
+	fs      afero.Fs
+	path    string
+	dirPerm os.FileMode
+	hash    func(string) string
+	mutex   sync.Mutex
+	files   map[string]RepositoryAttachment
+	pending map[string]pendingAttachment
+}
+
+// RepositoryAttachment is a single file saved by RepositoryAttachments
+type RepositoryAttachment struct {
+	FileName  string
+	MediaType string
+}
+
+// pendingAttachment is a file which was created but isn't known to be downloaded completely yet
+type pendingAttachment struct {
+	attachment RepositoryAttachment
+	realPath   string
+}
+
+// NewRepositoryAttachments creates attachments stored in attachmentsPath of the repository's file system, which
+// must be backed by the operating system's file system
+func NewRepositoryAttachments(config *model.Configuration, repoMan model.RepositoryManager, attachmentsPath string) *RepositoryAttachments {
+	result := new(RepositoryAttachments)
+	result.fs = repoMan.FileSystem()
+	result.path = attachmentsPath
+	result.dirPerm = repoMan.DirPerm()
+	result.hash = config.ContentAddressableStorageHash
+	result.files = make(map[string]RepositoryAttachment)
+	result.pending = make(map[string]pendingAttachment)
+	return result
+}
+
+// CreateFile creates the file for the attachment at url, it satisfies the resource.FileAttachmentPolicy interface;
+// the attachment is only recorded once Downloaded reports that it was saved completely
+func (ra *RepositoryAttachments) CreateFile(url *url.URL, t resource.Type) (*os.File, resource.Issue) {
+	urlText := url.String()
+	var mediaType string
+	if t != nil {
+		mediaType = t.MediaType()
+	}
+	fileName := ra.hash(urlText) + attachmentExtension(url, mediaType)
+
+	basePathFS, ok := ra.fs.(*afero.BasePathFs)
+	if !ok {
+		return nil, resource.NewIssue(urlText, "DLERR-0201-ATTACHMENTFS", fmt.Sprintf("Attachments require an operating system file system, not %T", ra.fs), true)
+	}
+	if err := ra.fs.MkdirAll(ra.path, ra.dirPerm); err != nil {
+		return nil, resource.NewIssue(urlText, "DLERR-0202-ATTACHMENTDIR", fmt.Sprintf("Unable to create attachments directory %q: %v", ra.path, err), true)
+	}
+	realPath, err := basePathFS.RealPath(filepath.Join(ra.path, fileName))
+	if err != nil {
+		return nil, resource.NewIssue(urlText, "DLERR-0203-ATTACHMENTPATH", fmt.Sprintf("Invalid attachment path for %q: %v", fileName, err), true)
+	}
+	file, err := os.Create(realPath)
+	if err != nil {
+		return nil, resource.NewIssue(urlText, "DLERR-0204-ATTACHMENTCREATE", fmt.Sprintf("Unable to create attachment %q: %v", realPath, err), true)
+	}
+
+	ra.mutex.Lock()
+	ra.pending[urlText] = pendingAttachment{attachment: RepositoryAttachment{FileName: fileName, MediaType: mediaType}, realPath: realPath}
+	ra.mutex.Unlock()
+	return file, nil
+}
+
+// Downloaded records the attachment created for url when it was saved completely and otherwise removes its file so
+// that a failed download doesn't leave a partial file behind
+func (ra *RepositoryAttachments) Downloaded(url *url.URL, saved bool) {
+	if ra == nil {
+		return
+	}
+	urlText := url.String()
+	ra.mutex.Lock()
+	pending, ok := ra.pending[urlText]
+	delete(ra.pending, urlText)
+	if ok && saved {
+		ra.files[urlText] = pending.attachment
+	}
+	ra.mutex.Unlock()
+	if ok && !saved {
+		os.Remove(pending.realPath)
+	}
+}
+
+// Attachment returns the attachment saved for urlText, if any
+func (ra *RepositoryAttachments) Attachment(urlText string) (RepositoryAttachment, bool) {
+	if ra == nil {
+		return RepositoryAttachment{}, false
+	}
+	ra.mutex.Lock()
+	defer ra.mutex.Unlock()
+	attachment, ok := ra.files[urlText]
+	return attachment, ok
+}
+
+// attachmentExtension prefers the extension in the URL's path, falling back to one registered for the media type
+func attachmentExtension(url *url.URL, mediaType string) string {
+	if ext := path.Ext(url.Path); len(ext) > 1 && len(ext) <= 6 {
+		return strings.ToLower(ext)
+	}
+	if len(mediaType) > 0 {
+		if exts, err := mime.ExtensionsByType(mediaType); err == nil && len(exts) > 0 {
+			return exts[0]
+		}
+	}
+	return ""
+}
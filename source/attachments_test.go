@@ -0,0 +1,89 @@
+package source
+
+import (
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+type testMediaType string
+
+func (t testMediaType) MediaType() string {
+	return string(t)
+}
+
+func TestRepositoryAttachments(t *testing.T) {
+	tests := []struct {
+		name      string
+		urlText   string
+		mediaType string
+		saved     bool
+		wantFile  string
+	}{
+		{"saved with URL extension", "https://example.com/paper.PDF", "application/pdf", true, "hash.pdf"},
+		{"saved with media type extension", "https://example.com/download?id=1", "application/json", true, "hash.json"},
+		{"failed download removed", "https://example.com/paper.pdf", "application/pdf", false, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir, dirErr := ioutil.TempDir("", "attachments")
+			if dirErr != nil {
+				t.Fatal(dirErr)
+			}
+			defer os.RemoveAll(dir)
+			ra := &RepositoryAttachments{
+				fs:      afero.NewBasePathFs(afero.NewOsFs(), dir),
+				path:    "attachments",
+				dirPerm: 0755,
+				hash:    func(string) string { return "hash" },
+				files:   make(map[string]RepositoryAttachment),
+				pending: make(map[string]pendingAttachment)}
+
+			u, _ := url.Parse(test.urlText)
+			file, issue := ra.CreateFile(u, testMediaType(test.mediaType))
+			if issue != nil || file == nil {
+				t.Fatalf("unable to create file: %v", issue)
+			}
+			file.WriteString("partial")
+			file.Close()
+			if _, ok := ra.Attachment(test.urlText); ok {
+				t.Error("attachment recorded before it was downloaded")
+			}
+
+			ra.Downloaded(u, test.saved)
+			attachment, ok := ra.Attachment(test.urlText)
+			if ok != test.saved || attachment.FileName != test.wantFile {
+				t.Errorf("got attachment %+v, %v, want %q", attachment, ok, test.wantFile)
+			}
+			files, _ := filepath.Glob(filepath.Join(dir, "attachments", "*"))
+			if test.saved && len(files) != 1 {
+				t.Errorf("got files %v, want the attachment", files)
+			}
+			if !test.saved && len(files) != 0 {
+				t.Errorf("partial files %v weren't removed", files)
+			}
+		})
+	}
+}
+
+func TestRepositoryAttachmentsRequireOSFileSystem(t *testing.T) {
+	ra := &RepositoryAttachments{
+		fs:      afero.NewMemMapFs(),
+		path:    "attachments",
+		hash:    func(string) string { return "hash" },
+		files:   make(map[string]RepositoryAttachment),
+		pending: make(map[string]pendingAttachment)}
+	u, _ := url.Parse("https://example.com/paper.pdf")
+	if file, _ := ra.CreateFile(u, nil); file != nil {
+		file.Close()
+		t.Error("created a file outside of an operating system file system")
+	}
+	ra.Downloaded(u, true)
+	if _, ok := ra.Attachment(u.String()); ok {
+		t.Error("recorded an attachment which wasn't created")
+	}
+}
@@ -0,0 +1,85 @@
+package source
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/lectio/graph/model"
+)
+
+// CSVListSourceName is the registered name of the local CSV file of links source
+const CSVListSourceName = "CSVList"
+
+// TSVListSourceName is the registered name of the local TSV file of links source
+const TSVListSourceName = "TSVList"
+
+func init() {
+	pattern, _ := model.MakeRegularExpression(`(?i)^file\://.+\.csv$`)
+	MustRegister(&Registration{Name: CSVListSourceName, URLPattern: pattern, Handler: NewListLinksHandler("CSV", ParseCSVList)})
+
+	pattern, _ = model.MakeRegularExpression(`(?i)^file\://.+\.tsv$`)
+	MustRegister(&Registration{Name: TSVListSourceName, URLPattern: pattern, Handler: NewListLinksHandler("TSV", ParseTSVList)})
+}
+
+// ParseCSVList reads a comma separated file whose first row is a header; columns are mapped using the
+// SourceSettings Columns
+func ParseCSVList(content []byte, ss *model.SourceSettings) ([]*ListItem, error) {
+	return parseDelimitedList(content, ',', ss)
+}
+
+// ParseTSVList reads a tab separated file whose first row is a header; columns are mapped using the
+// SourceSettings Columns
+func ParseTSVList(content []byte, ss *model.SourceSettings) ([]*ListItem, error) {
+	return parseDelimitedList(content, '\t', ss)
+}
+
+func parseDelimitedList(content []byte, comma rune, ss *model.SourceSettings) ([]*ListItem, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.Comma = comma
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse delimited list: %v", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns := ss.Columns
+	header := make(map[string]int)
+	for index, name := range records[0] {
+		header[strings.ToLower(strings.TrimSpace(name))] = index
+	}
+	column := func(name string) int {
+		if index, ok := header[strings.ToLower(name)]; ok {
+			return index
+		}
+		return -1
+	}
+	urlCol, titleCol, tagsCol, dateCol := column(columns.URL), column(columns.Title), column(columns.Tags), column(columns.Date)
+	if urlCol < 0 {
+		return nil, fmt.Errorf("unable to parse delimited list: URL column %q not found in header %v", columns.URL, records[0])
+	}
+
+	cell := func(record []string, index int) string {
+		if index < 0 || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	var result []*ListItem
+	for _, record := range records[1:] {
+		item := &ListItem{
+			Link:  cell(record, urlCol),
+			Title: cell(record, titleCol),
+			Tags:  splitTags(cell(record, tagsCol), columns.TagsDelimiter),
+			Date:  cell(record, dateCol)}
+		result = append(result, item)
+	}
+	return result, nil
+}
@@ -0,0 +1,158 @@
+package source
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/html"
+
+	"github.com/lectio/graph/model"
+)
+
+// maxDestinationPageSize limits how much of a destination page is read
+const maxDestinationPageSize = 5 * 1024 * 1024
+
+// destination returns the parsed page the traversal read from u, or nil if it didn't read it
+func (lt *linkTraversal) destination(u *url.URL) (*html.Node, error) {
+	lt.mutex.Lock()
+	page := lt.page
+	found := lt.pageURL != nil && lt.pageURL.String() == u.String()
+	lt.mutex.Unlock()
+	if !found {
+		return nil, nil
+	}
+	return html.Parse(bytes.NewReader(page))
+}
+
+// capturePage returns resp with its body recorded into the traversal, as the page read by hop, when it's a
+// retrieved HTML page
+func capturePage(traversal *linkTraversal, hop *redirectHop, req *http.Request, resp *http.Response) *http.Response {
+	if resp.StatusCode != http.StatusOK || !isHTMLMediaType(resp.Header.Get("Content-Type")) {
+		return resp
+	}
+	resp.Body = &capturingBody{ReadCloser: resp.Body, traversal: traversal, hop: hop, url: req.URL}
+	return resp
+}
+
+// capturingBody copies what's read from a response body and records it as a page once it's read completely
+type capturingBody struct {
+	io.ReadCloser
+	traversal *linkTraversal
+	hop       *redirectHop
+	url       *url.URL
+	content   bytes.Buffer
+	done      bool
+}
+
+func (cb *capturingBody) Read(p []byte) (int, error) {
+	n, err := cb.ReadCloser.Read(p)
+	if cb.done {
+		return n, err
+	}
+	cb.content.Write(p[:n])
+	// pages are harvested up to maxDestinationPageSize so reaching it is as good as reading all of it
+	if err == io.EOF || cb.content.Len() >= maxDestinationPageSize {
+		cb.done = true
+		page := cb.content.Bytes()
+		if len(page) > maxDestinationPageSize {
+			page = page[:maxDestinationPageSize]
+		}
+		cb.traversal.setPage(cb.hop, cb.url, page)
+	}
+	return n, err
+}
+
+// isHTMLMediaType returns true if contentType is an HTML media type, or isn't known
+func isHTMLMediaType(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return len(mediaType) == 0 || mediaType == "text/html" || mediaType == "application/xhtml+xml"
+}
+
+// FetchDestination retrieves and parses the HTML page at u, returning the document and the URL it was
+// retrieved from after any redirects
+func FetchDestination(lm LinksManager, u *url.URL) (*html.Node, *url.URL, error) {
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	lm.PrepareRequest(lm.HTTPClient(), req)
+	resp, err := lm.HTTPClient().Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("HTTP status %d", resp.StatusCode)
+	}
+	if contentType := resp.Header.Get("Content-Type"); !isHTMLMediaType(contentType) {
+		return nil, nil, fmt.Errorf("content type %q is not HTML", contentType)
+	}
+	doc, err := html.Parse(io.LimitReader(resp.Body, maxDestinationPageSize))
+	if err != nil {
+		return nil, nil, err
+	}
+	return doc, resp.Request.URL, nil
+}
+
+// HarvestDestination applies the canonical URL, the metadata and the readable content of the HTML page the bookmark
+// links to, when they're wanted, to the bookmark according to the content settings. The page is the one read while
+// traversing the link, carried by lm's context; it's only retrieved again when the traversal didn't read it and the
+// metadata or the content are wanted, and never when the link wasn't traversed, for example because robots.txt
+// disallows it. The canonical URL is always applied, whatever the metadata settings, because it identifies the page
+// and so decides which bookmarks are duplicates. The caller holds the link's pool slot.
+func HarvestDestination(bookmark *model.Bookmark, finalURL *url.URL, lm LinksManager, cs *model.ContentSettings, warnFn func(code, message string)) {
+	wantMetadata := lm.ParseMetaDataInHTMLContent(finalURL)
+	wantBody := extractBodyWanted(bookmark, &cs.Body)
+	traversal := traversalFromContext(lm.Context)
+	if traversal == nil || !traversal.isTraversed() {
+		return
+	}
+
+	base := finalURL
+	doc, err := traversal.destination(finalURL)
+	if doc == nil && err == nil {
+		if !wantMetadata && !wantBody {
+			// the canonical URL alone isn't worth retrieving the page again
+			return
+		}
+		doc, base, err = FetchDestination(lm, finalURL)
+	}
+	if err != nil {
+		warnFn("DLWARN-0108-DESTINATION", fmt.Sprintf("Unable to retrieve %q: %v", finalURL.String(), err))
+		return
+	}
+
+	// metadata is read first because extracting the readable content removes the document's head and scripts
+	metadata := PageMetadata(doc, base)
+	applyCanonicalURL(bookmark, metadata, lm, warnFn)
+	if wantMetadata {
+		applyPageMetadata(bookmark, metadata, cs)
+	}
+	if wantBody && extractBody(bookmark, doc, base, &cs.Body, warnFn) {
+		// the summary may be derived from the body so it's edited again now that the body is known
+		bookmark.Summary.Edit(bookmark, &cs.Summary)
+	}
+	if wantMetadata && cs.Summary.FallbackToMetaDescription && len(bookmark.Summary) == 0 {
+		bookmark.Summary = model.ContentSummaryText(firstMetadata(metadata, descriptionProperties...))
+	}
+}
+
+// applyCanonicalURL derives the bookmark's ID from the page's <link rel=canonical> so that bookmarks of the same
+// page under different URLs get the same ID
+func applyCanonicalURL(bookmark *model.Bookmark, metadata *model.Properties, lm LinksManager, warnFn func(code, message string)) {
+	canonical := firstMetadata(metadata, "link.canonical")
+	if len(canonical) == 0 {
+		return
+	}
+	canonicalURL, err := url.Parse(canonical)
+	if err != nil || (canonicalURL.Scheme != "http" && canonicalURL.Scheme != "https") || len(canonicalURL.Host) == 0 {
+		warnFn("DLWARN-0109-CANONICAL", fmt.Sprintf("Ignoring invalid canonical URL %q", canonical))
+		return
+	}
+	bookmark.ID = lm.Config.ContentAddressableStorageHash(canonicalURL.String())
+	bookmark.Link.CanonicalURL = model.MakeURL(canonicalURL)
+}
@@ -0,0 +1,174 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/lectio/graph/model"
+)
+
+const testDestinationPage = `<html><head>
+<title>HTML Title</title>
+<meta property="og:title" content="OpenGraph Title">
+<meta property="og:description" content="The page's description.">
+<link rel="canonical" href="https://example.com/canonical">
+</head><body><p>Body</p></body></html>`
+
+// newDestinationTestServer serves an HTML page at /page, redirects /moved to it and counts the requests for it
+func newDestinationTestServer(contentType string) (*httptest.Server, func() int) {
+	var mutex sync.Mutex
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/moved":
+			http.Redirect(w, r, "/page", http.StatusMovedPermanently)
+		case "/page":
+			mutex.Lock()
+			requests++
+			mutex.Unlock()
+			w.Header().Set("Content-Type", contentType)
+			fmt.Fprint(w, testDestinationPage)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	return server, func() int {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return requests
+	}
+}
+
+func TestHarvestDestination(t *testing.T) {
+	tests := []struct {
+		name          string
+		contentType   string
+		metadata      bool
+		traversed     bool
+		path          string
+		readBody      bool
+		wantRequests  int
+		wantTitle     string
+		wantCanonical bool
+	}{
+		{"page read by traversal", "text/html; charset=utf-8", true, true, "/page", true, 1, "OpenGraph Title", true},
+		{"redirected page read by traversal", "text/html", true, true, "/moved", true, 1, "OpenGraph Title", true},
+		{"page without content type", "", true, true, "/page", true, 1, "OpenGraph Title", true},
+		{"page not read by traversal", "text/html", true, true, "/page", false, 2, "OpenGraph Title", true},
+		{"not HTML", "application/pdf", true, true, "/page", true, 2, "", false},
+		{"not traversed", "text/html", true, false, "/page", false, 0, "", false},
+		{"canonical URL without metadata", "text/html", false, true, "/page", true, 1, "", true},
+		{"canonical URL without metadata not retrieved again", "text/html", false, true, "/page", false, 1, "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, requests := newDestinationTestServer(test.contentType)
+			defer server.Close()
+			params := newTestParams(t, server.URL+"/links.txt", func(config *model.Configuration, path model.SettingsPath) {
+				config.LinkLifecyleSettings(path).ParseMetaDataInLinkDestinationHTMLContent = test.metadata
+				config.ContentSettings(path).Metadata.FillMissingTitle = true
+			})
+			lm := *params.LinksManager()
+			lt := new(linkTraversal)
+			lm.Context = withLinkTraversal(context.Background(), lt)
+
+			finalURL, _ := url.Parse(server.URL + "/page")
+			if test.traversed {
+				// the traversal reads the page, as link.TraverseLink does, through the client with the traversal's
+				// context
+				lt.setTraversed()
+				req, _ := http.NewRequest(http.MethodGet, server.URL+test.path, nil)
+				req = req.WithContext(lm.Context)
+				resp, err := lm.HTTPClient().Do(req)
+				if err != nil {
+					t.Fatalf("traversal failed: %v", err)
+				}
+				if test.readBody {
+					ioutil.ReadAll(resp.Body)
+				}
+				resp.Body.Close()
+			}
+
+			bookmark := model.Bookmark{Link: model.BookmarkLink{OriginalURLText: model.URLText(server.URL + test.path)}}
+			HarvestDestination(&bookmark, finalURL, lm, params.ContentSettings(), func(code, message string) {})
+			if got := requests(); got != test.wantRequests {
+				t.Errorf("page was requested %d times, want %d", got, test.wantRequests)
+			}
+			if string(bookmark.Title) != test.wantTitle {
+				t.Errorf("got title %q, want %q", bookmark.Title, test.wantTitle)
+			}
+			// the canonical URL identifies the page, so it's read whether or not the metadata is wanted
+			wantID := ""
+			if test.wantCanonical {
+				wantID = lm.Config.ContentAddressableStorageHash("https://example.com/canonical")
+			}
+			if gotCanonical := bookmark.Link.CanonicalURL != nil && bookmark.Link.CanonicalURL.Text() == "https://example.com/canonical"; gotCanonical != test.wantCanonical || bookmark.ID != wantID {
+				t.Errorf("got canonical URL %+v and ID %q, want canonical %v", bookmark.Link.CanonicalURL, bookmark.ID, test.wantCanonical)
+			}
+		})
+	}
+}
+
+func TestCapturingBodyLimitsPageSize(t *testing.T) {
+	lt := new(linkTraversal)
+	u, _ := url.Parse("https://example.com/page")
+	page := make([]byte, maxDestinationPageSize+1024)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write(page)
+	}))
+	defer server.Close()
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	req.URL = u
+	resp = capturePage(lt, new(redirectHop), req, resp)
+	ioutil.ReadAll(resp.Body)
+	if len(lt.page) != maxDestinationPageSize || lt.pageURL != u {
+		t.Errorf("captured %d bytes of %v, want %d of %v", len(lt.page), lt.pageURL, maxDestinationPageSize, u)
+	}
+}
+
+func TestHarvestDestinationFallsBackToMetaDescription(t *testing.T) {
+	tests := []struct {
+		name     string
+		fallback bool
+		summary  model.ContentSummaryText
+		want     model.ContentSummaryText
+	}{
+		{"empty summary", true, "", "The page's description."},
+		{"summary kept", true, "The bookmark's summary.", "The bookmark's summary."},
+		{"fallback disabled", false, "", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, _ := newDestinationTestServer("text/html")
+			defer server.Close()
+			params := newTestParams(t, server.URL+"/links.txt", func(config *model.Configuration, path model.SettingsPath) {
+				config.LinkLifecyleSettings(path).ParseMetaDataInLinkDestinationHTMLContent = true
+				config.ContentSettings(path).Metadata.FillMissingSummary = false
+				config.ContentSettings(path).Summary.FallbackToMetaDescription = test.fallback
+			})
+			lm := *params.LinksManager()
+			lt := new(linkTraversal)
+			lt.setTraversed()
+			lm.Context = withLinkTraversal(context.Background(), lt)
+
+			finalURL, _ := url.Parse(server.URL + "/page")
+			bookmark := model.Bookmark{Summary: test.summary}
+			HarvestDestination(&bookmark, finalURL, lm, params.ContentSettings(), func(code, message string) {})
+			if bookmark.Summary != test.want {
+				t.Errorf("got summary %q, want %q", bookmark.Summary, test.want)
+			}
+		})
+	}
+}
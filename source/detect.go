@@ -2,7 +2,6 @@ package source
 
 import (
 	"fmt"
-	"regexp"
 
 	"github.com/lectio/graph/model"
 )
@@ -10,21 +9,9 @@ import (
 // UnknownSourceName is the value used for model.ContentSource.Name when the source is unknown
 const UnknownSourceName = "Unidentified"
 
-// DetectFromURLText detects the ContentSource given a URL string
+// DetectFromURLText detects the ContentSource given a URL string using the sources in DefaultRegistry
 func DetectFromURLText(source model.URLText) (model.ContentSource, LinksAPIHandlerFunc, error) {
-	result := model.BookmarksAPISource{}
-	dropmark := regexp.MustCompile(`^https\://(.*).dropmark.com/([0-9]+).json$`)
-
-	switch {
-	case dropmark.MatchString(string(source)):
-		result.Name = "Dropmark"
-		result.APIEndpoint = source
-		return &result, DropmarkLinks, nil
-	default:
-		result.Name = UnknownSourceName
-		result.APIEndpoint = source
-		return &result, nil, fmt.Errorf("unable to detect %q as a valid ContentSource in source.DetectFromURLText", source)
-	}
+	return DefaultRegistry.Detect(source)
 }
 
 // DetectAPIFromURLText detects the APISource given a URL string
@@ -2,15 +2,22 @@ package source
 
 import (
 	"fmt"
-	"sync"
 	"time"
 
+	"github.com/araddon/dateparse"
 	"github.com/lectio/dropmark"
-	ll "github.com/lectio/link"
 
 	"github.com/lectio/graph/model"
 )
 
+// DropmarkSourceName is the registered name of the Dropmark bookmarks source
+const DropmarkSourceName = "Dropmark"
+
+func init() {
+	pattern, _ := model.MakeRegularExpression(`^https\://(.*).dropmark.com/([0-9]+).json$`)
+	MustRegister(&Registration{Name: DropmarkSourceName, URLPattern: pattern, Handler: DropmarkLinks})
+}
+
 // NewBookmarkFromDropmarkLink uses the dropmark.Item to create a model.Bookmark
 func NewBookmarkFromDropmarkLink(item *dropmark.Item, lm *LinksManager, cs *model.ContentSettings, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
 	if len(item.DeletedAt) > 0 {
@@ -30,57 +37,16 @@ func NewBookmarkFromDropmarkLink(item *dropmark.Item, lm *LinksManager, cs *mode
 		Body:       model.ContentBodyText(item.Content),
 		Properties: model.MakeProperties()}
 
-	bookmark.Title.Edit(&bookmark, &cs.Title)
-	bookmark.Summary.Edit(&bookmark, &cs.Summary)
-	bookmark.Body.Edit(&bookmark, &cs.Body)
-
-	if bookmark.Link.OriginalURLText.IsEmpty() {
-		warnFn("DLWARN-0101-LINKEMPTY", "Empty link")
+	if !FinalizeBookmark(&bookmark, lm, cs, errorFn, warnFn) {
 		return nil
 	}
 
-	link, linkErr := bookmark.Link.OriginalURLText.Link(lm)
-	if linkErr != nil || link == nil {
-		errorFn("DLERR-0101-LINKERR", fmt.Sprintf("Unable to create link.Link: %v", linkErr))
-		return nil
-	}
-	managedLink, isManagedLink := link.(ll.ManagedLink)
-
-	if isManagedLink && managedLink.Issues() != nil {
-		managedLink.Issues().HandleIssues(
-			func(err ll.Issue) {
-				errorFn(string(err.IssueCode()), err.Issue())
-			},
-			func(warning ll.Issue) {
-				warnFn(string(warning.IssueCode()), warning.Issue())
-			})
-	}
-
-	finalURL, finalURLErr := link.FinalURL()
-	if finalURLErr != nil {
-		errorFn("DMERR-LINK_FINALURL", finalURLErr.Error())
-		return nil
-	}
-
-	// this shouldnt occur because it should be caught by "issues" block above but, just in case...
-	if isManagedLink {
-		ignore, ignoreReason := managedLink.Ignore()
-		if ignore {
-			warnFn("DLWARN-0100-IGNORE", ignoreReason)
-			return nil
-		}
-	}
-
-	bookmark.ID = lm.Config.ContentAddressableStorageHash(finalURL.String())
-	bookmark.Link.IsValid = true
-	bookmark.Link.FinalURL = model.MakeURL(finalURL)
-
 	if item.Tags != nil && len(item.Tags) > 0 {
-		categories := model.FlatTaxonomy{Name: "categories"}
-		for _, tag := range item.Tags {
-			categories.Add(model.TaxonName(tag.Name))
+		tags := make([]string, len(item.Tags))
+		for i, tag := range item.Tags {
+			tags[i] = tag.Name
 		}
-		bookmark.Taxonomies = append(bookmark.Taxonomies, categories)
+		bookmark.Taxonomies = append(bookmark.Taxonomies, cs.Taxonomies.TagsTaxonomy("categories", tags))
 	}
 
 	bookmark.Properties.Add("dropmark.editURL", item.DropmarkEditURL)
@@ -101,86 +67,44 @@ func DropmarkLinks(params LinksAPIHandlerParams) (*model.Bookmarks, error) {
 	hcs := params.HTTPClientSettings()
 	lm := params.LinksManager()
 	cs := params.ContentSettings()
+	harvester := NewBookmarksHarvester(params, source)
 
-	dropColl := model.Bookmarks{}
-	dropColl.Source = *source
-	dropColl.Activities = model.Activities{}
-
-	dropCollMutex := sync.RWMutex{}
-	asynch := params.Asynch()
-
+	// the Dropmark API client can't be cancelled so the harvest stops before or after it instead
+	if harvester.Cancelled() {
+		return harvester.Bookmarks, nil
+	}
 	dc, issues := dropmark.GetCollection(string(source.APIEndpoint), pr, hcs.UserAgent, time.Duration(hcs.Timeout))
+	if harvester.Cancelled() {
+		return harvester.Bookmarks, nil
+	}
 	if issues != nil {
 		issues.HandleIssues(
 			func(err dropmark.Issue) {
-				dropColl.Activities.AddError(string(source.APIEndpoint), string(err.IssueCode()), err.Issue())
+				harvester.AddError(string(source.APIEndpoint), string(err.IssueCode()), err.Issue())
 			},
 			func(warning dropmark.Issue) {
-				dropColl.Activities.AddWarning(string(source.APIEndpoint), string(warning.IssueCode()), warning.Issue())
+				harvester.AddWarning(string(source.APIEndpoint), string(warning.IssueCode()), warning.Issue())
 			})
-		return &dropColl, nil
+		return harvester.Bookmarks, nil
 	}
 
-	createBookmark := func(index int, item *dropmark.Item) bool {
-		issueContext := fmt.Sprintf("[%s] Dropmark link %d %q", source.APIEndpoint, index, item.Link)
-		bookmark := NewBookmarkFromDropmarkLink(item, lm, cs,
-			func(code, message string) {
-				if asynch {
-					dropCollMutex.Lock()
-					dropColl.Activities.AddError(issueContext, code, message)
-					dropCollMutex.Unlock()
-				} else {
-					dropColl.Activities.AddError(issueContext, code, message)
-				}
-			},
-			func(code, message string) {
-				if asynch {
-					dropCollMutex.Lock()
-					dropColl.Activities.AddWarning(issueContext, code, message)
-					dropCollMutex.Unlock()
-				} else {
-					dropColl.Activities.AddWarning(issueContext, code, message)
-				}
-			})
-
-		if bookmark != nil {
-			if asynch {
-				dropCollMutex.Lock()
-				dropColl.Content = append(dropColl.Content, *bookmark)
-				dropCollMutex.Unlock()
-			} else {
-				dropColl.Content = append(dropColl.Content, *bookmark)
-			}
-			return true
-		}
-		return false
-	}
-
-	pr.StartReportableActivity(fmt.Sprintf("Importing %d %s Links from %q", len(dc.Items), source.Name, source.APIEndpoint), len(dc.Items))
-	if asynch {
-		var wg sync.WaitGroup
-		queue := make(chan int)
-		for index, item := range dc.Items {
-			wg.Add(1)
-			go func(index int, item *dropmark.Item) {
-				defer wg.Done()
-				createBookmark(index, item)
-				queue <- index
-			}(index, item)
-		}
-		go func() {
-			defer close(queue)
-			wg.Wait()
-		}()
-		for range queue {
-			pr.IncrementReportableActivityProgress()
-		}
-	} else {
-		for index, item := range dc.Items {
-			createBookmark(index, item)
-			pr.IncrementReportableActivityProgress()
+	links := make([]model.URLText, 0, len(dc.Items))
+	for _, item := range dc.Items {
+		if len(item.DeletedAt) == 0 {
+			links = append(links, model.URLText(item.Link))
 		}
 	}
-	pr.CompleteReportableActivityProgress(fmt.Sprintf("Imported %d of %d %s Links from %q", len(dropColl.Content), len(dc.Items), source.Name, source.APIEndpoint))
-	return &dropColl, nil
+	harvester.SetSourceLinks(links)
+
+	return harvester.HarvestChanged(len(dc.Items),
+		func(index int) time.Time {
+			updatedAt, _ := dateparse.ParseAny(dc.Items[index].UpdatedAt)
+			return updatedAt
+		},
+		func(index int) string {
+			return fmt.Sprintf("[%s] Dropmark link %d %q", source.APIEndpoint, index, dc.Items[index].Link)
+		},
+		func(index int, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+			return NewBookmarkFromDropmarkLink(dc.Items[index], lm, cs, errorFn, warnFn)
+		}), nil
 }
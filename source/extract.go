@@ -0,0 +1,392 @@
+package source
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/lectio/graph/model"
+)
+
+var (
+	unlikelyCandidatesRegExp = regexp.MustCompile(`(?i)ad-|advert|banner|breadcrumb|combx|comment|community|cookie|disqus|footer|header|menu|modal|nav|newsletter|outbrain|pagination|popup|promo|related|remark|rss|share|shoutbox|sidebar|social|sponsor|subscribe|taboola|tags|tool|widget`)
+	likelyCandidatesRegExp   = regexp.MustCompile(`(?i)and|article|body|column|content|entry|main|page|post|shadow|story|text`)
+	positiveWeightRegExp     = regexp.MustCompile(`(?i)article|body|content|entry|hentry|h-entry|main|page|post|story|text|blog`)
+	negativeWeightRegExp     = regexp.MustCompile(`(?i)hidden|banner|combx|comment|com-|contact|foot|footer|footnote|masthead|media|meta|outbrain|promo|related|scroll|share|shoutbox|sidebar|skyscraper|sponsor|shopping|tags|tool|widget`)
+	whitespaceRegExp         = regexp.MustCompile(`\s+`)
+	blankLinesRegExp         = regexp.MustCompile(`\n{3,}`)
+	listItemRegExp           = regexp.MustCompile(`^ *([-*+]|\d+\.) `)
+)
+
+// removedElements never contain the readable content of a page
+var removedElements = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Noscript: true, atom.Nav: true, atom.Header: true, atom.Footer: true,
+	atom.Aside: true, atom.Form: true, atom.Iframe: true, atom.Svg: true, atom.Button: true, atom.Select: true,
+	atom.Input: true, atom.Textarea: true, atom.Object: true, atom.Embed: true, atom.Link: true, atom.Meta: true,
+}
+
+// ReadableContent finds the main article content in an HTML document, in the style of readability, and converts
+// it to markdown; relative links and images are resolved against base. The unlikely parts of doc are removed.
+func ReadableContent(doc *html.Node, base *url.URL) (string, error) {
+	removeUnlikelyCandidates(doc)
+
+	scores := make(map[*html.Node]float64)
+	var candidates []*html.Node
+	addScore := func(node *html.Node, score float64) {
+		if node == nil || node.Type != html.ElementNode {
+			return
+		}
+		if _, ok := scores[node]; !ok {
+			scores[node] = elementWeight(node)
+			candidates = append(candidates, node)
+		}
+		scores[node] += score
+	}
+
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		if node.Type == html.ElementNode && (node.DataAtom == atom.P || node.DataAtom == atom.Pre || node.DataAtom == atom.Td) {
+			text := nodeText(node)
+			if len(text) >= 25 {
+				score := 1 + float64(strings.Count(text, ",")) + math.Min(float64(len(text))/100, 3)
+				addScore(node.Parent, score)
+				if node.Parent != nil {
+					addScore(node.Parent.Parent, score/2)
+				}
+			}
+		}
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(doc)
+
+	var best *html.Node
+	bestScore := 0.0
+	for _, candidate := range candidates {
+		score := scores[candidate] * (1 - linkDensity(candidate))
+		if best == nil || score > bestScore {
+			best = candidate
+			bestScore = score
+		}
+	}
+	if best == nil {
+		return "", fmt.Errorf("no readable content found")
+	}
+
+	var md strings.Builder
+	writeMarkdown(&md, best, base)
+	return tidyMarkdown(md.String()), nil
+}
+
+// tidyMarkdown removes the whitespace left between blocks by the HTML source, except inside code blocks and the
+// indentation of nested list items
+func tidyMarkdown(md string) string {
+	lines := strings.Split(md, "\n")
+	inCode := false
+	for index, line := range lines {
+		if strings.HasPrefix(line, "```") {
+			inCode = !inCode
+		}
+		if !inCode {
+			if !listItemRegExp.MatchString(line) {
+				lines[index] = strings.TrimLeft(line, " ")
+			}
+			if len(strings.TrimSpace(line)) == 0 {
+				lines[index] = ""
+			}
+		}
+	}
+	return strings.TrimSpace(blankLinesRegExp.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+// removeUnlikelyCandidates detaches the elements that are unlikely to be part of the article content
+func removeUnlikelyCandidates(node *html.Node) {
+	for child := node.FirstChild; child != nil; {
+		next := child.NextSibling
+		if child.Type == html.CommentNode {
+			node.RemoveChild(child)
+		} else if child.Type == html.ElementNode {
+			classAndID := attribute(child, "class") + " " + attribute(child, "id")
+			unlikely := unlikelyCandidatesRegExp.MatchString(classAndID) && !likelyCandidatesRegExp.MatchString(classAndID) &&
+				child.DataAtom != atom.Body && child.DataAtom != atom.Article && child.DataAtom != atom.Main
+			if removedElements[child.DataAtom] || unlikely {
+				node.RemoveChild(child)
+			} else {
+				removeUnlikelyCandidates(child)
+			}
+		}
+		child = next
+	}
+}
+
+// elementWeight is the initial score of a candidate based on its tag, class and id
+func elementWeight(node *html.Node) float64 {
+	weight := 0.0
+	switch node.DataAtom {
+	case atom.Article, atom.Main:
+		weight += 10
+	case atom.Div:
+		weight += 5
+	case atom.Pre, atom.Td, atom.Blockquote:
+		weight += 3
+	case atom.Form, atom.Ol, atom.Ul, atom.Dl, atom.Dd, atom.Dt, atom.Li:
+		weight -= 3
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Th:
+		weight -= 5
+	}
+	for _, value := range []string{attribute(node, "class"), attribute(node, "id")} {
+		if len(value) == 0 {
+			continue
+		}
+		if negativeWeightRegExp.MatchString(value) {
+			weight -= 25
+		}
+		if positiveWeightRegExp.MatchString(value) {
+			weight += 25
+		}
+	}
+	return weight
+}
+
+// linkDensity is the fraction of a node's text which is inside links
+func linkDensity(node *html.Node) float64 {
+	textLength := len(nodeText(node))
+	if textLength == 0 {
+		return 0
+	}
+	linkLength := 0
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.DataAtom == atom.A {
+			linkLength += len(nodeText(n))
+			return
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(node)
+	return float64(linkLength) / float64(textLength)
+}
+
+// nodeText returns the text of a node and its descendants with whitespace collapsed
+func nodeText(node *html.Node) string {
+	var text strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			text.WriteString(n.Data)
+			text.WriteString(" ")
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(node)
+	return strings.TrimSpace(whitespaceRegExp.ReplaceAllString(text.String(), " "))
+}
+
+func attribute(node *html.Node, key string) string {
+	for _, attr := range node.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+// resolveReference returns ref as an absolute URL relative to base, or ref unchanged if it can't be parsed
+func resolveReference(base *url.URL, ref string) string {
+	refURL, err := url.Parse(strings.TrimSpace(ref))
+	if err != nil || base == nil {
+		return ref
+	}
+	return base.ResolveReference(refURL).String()
+}
+
+// writeMarkdown converts the node and its descendants to markdown
+func writeMarkdown(md *strings.Builder, node *html.Node, base *url.URL) {
+	children := func() {
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			writeMarkdown(md, child, base)
+		}
+	}
+	// inline converts the children to markdown on a single line so that links and emphasis inside are kept
+	inline := func() string {
+		var text strings.Builder
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			writeMarkdown(&text, child, base)
+		}
+		return strings.TrimSpace(whitespaceRegExp.ReplaceAllString(text.String(), " "))
+	}
+
+	switch node.Type {
+	case html.TextNode:
+		md.WriteString(whitespaceRegExp.ReplaceAllString(node.Data, " "))
+		return
+	case html.ElementNode:
+	default:
+		children()
+		return
+	}
+
+	switch node.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level := int(node.Data[1] - '0')
+		md.WriteString("\n\n" + strings.Repeat("#", level) + " " + inline() + "\n\n")
+	case atom.P, atom.Div, atom.Section, atom.Article, atom.Main, atom.Figure, atom.Table:
+		md.WriteString("\n\n")
+		children()
+		md.WriteString("\n\n")
+	case atom.Br:
+		md.WriteString("  \n")
+	case atom.Hr:
+		md.WriteString("\n\n---\n\n")
+	case atom.Strong, atom.B:
+		if text := inline(); len(text) > 0 {
+			md.WriteString("**" + text + "**")
+		}
+	case atom.Em, atom.I:
+		if text := inline(); len(text) > 0 {
+			md.WriteString("_" + text + "_")
+		}
+	case atom.Code:
+		// markdown isn't rendered inside code spans so a link inside the code goes around it instead
+		code := "`" + nodeText(node) + "`"
+		if href := linkHref(node); linkable(href) {
+			code = "[" + code + "](" + resolveReference(base, href) + ")"
+		}
+		md.WriteString(code)
+	case atom.Pre:
+		md.WriteString("\n\n```\n" + strings.TrimSpace(preformattedText(node)) + "\n```\n\n")
+	case atom.Blockquote:
+		var quote strings.Builder
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			writeMarkdown(&quote, child, base)
+		}
+		md.WriteString("\n\n")
+		for _, line := range strings.Split(tidyMarkdown(quote.String()), "\n") {
+			md.WriteString(strings.TrimRight("> "+line, " ") + "\n")
+		}
+		md.WriteString("\n")
+	case atom.Ul, atom.Ol:
+		md.WriteString("\n\n")
+		writeList(md, node, base, "")
+		md.WriteString("\n")
+	case atom.A:
+		text := inline()
+		href := attribute(node, "href")
+		if len(text) == 0 {
+			return
+		}
+		if !linkable(href) {
+			md.WriteString(text)
+			return
+		}
+		md.WriteString("[" + text + "](" + resolveReference(base, href) + ")")
+	case atom.Img:
+		if src := attribute(node, "src"); len(src) > 0 && !strings.HasPrefix(src, "data:") {
+			md.WriteString("![" + attribute(node, "alt") + "](" + resolveReference(base, src) + ")")
+		}
+	case atom.Tr:
+		md.WriteString("\n")
+		children()
+	case atom.Td, atom.Th:
+		children()
+		md.WriteString(" ")
+	default:
+		children()
+	}
+}
+
+// writeList converts the items of a <ul> or <ol> to markdown, one line each prefixed with indent; the lists nested
+// in an item follow it on their own lines, indented under the item's text
+func writeList(md *strings.Builder, node *html.Node, base *url.URL, indent string) {
+	index := 0
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type != html.ElementNode || child.DataAtom != atom.Li {
+			continue
+		}
+		index++
+		bullet := "- "
+		if node.DataAtom == atom.Ol {
+			bullet = fmt.Sprintf("%d. ", index)
+		}
+		var item, nested strings.Builder
+		for grandchild := child.FirstChild; grandchild != nil; grandchild = grandchild.NextSibling {
+			if grandchild.Type == html.ElementNode && (grandchild.DataAtom == atom.Ul || grandchild.DataAtom == atom.Ol) {
+				writeList(&nested, grandchild, base, indent+strings.Repeat(" ", len(bullet)))
+				continue
+			}
+			writeMarkdown(&item, grandchild, base)
+		}
+		md.WriteString(indent + bullet + strings.TrimSpace(whitespaceRegExp.ReplaceAllString(item.String(), " ")) + "\n")
+		md.WriteString(nested.String())
+	}
+}
+
+// linkable returns true if href leads to another page, rather than within the page or to a script
+func linkable(href string) bool {
+	return len(href) > 0 && !strings.HasPrefix(href, "#") && !strings.HasPrefix(strings.ToLower(href), "javascript:")
+}
+
+// linkHref returns the href of the first link in node, or an empty string if there is none
+func linkHref(node *html.Node) string {
+	if node.Type == html.ElementNode && node.DataAtom == atom.A {
+		return attribute(node, "href")
+	}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if href := linkHref(child); len(href) > 0 {
+			return href
+		}
+	}
+	return ""
+}
+
+// preformattedText returns the text of a node without collapsing its whitespace
+func preformattedText(node *html.Node) string {
+	var text strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			text.WriteString(n.Data)
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(node)
+	return text.String()
+}
+
+// extractBodyWanted returns true if the ContentBodySettings extract policy applies to the bookmark
+func extractBodyWanted(bookmark *model.Bookmark, settings *model.ContentBodySettings) bool {
+	switch settings.ExtractPolicy {
+	case model.ContentBodyExtractPolicyAlwaysExtract:
+		return true
+	case model.ContentBodyExtractPolicyExtractIfEmpty:
+		return len(strings.TrimSpace(string(bookmark.Body))) == 0
+	}
+	return false
+}
+
+// extractBody replaces the bookmark's body with the readable content of doc, returning true if it was replaced
+func extractBody(bookmark *model.Bookmark, doc *html.Node, base *url.URL, settings *model.ContentBodySettings, warnFn func(code, message string)) bool {
+	content, err := ReadableContent(doc, base)
+	if err != nil {
+		warnFn("DLWARN-0106-EXTRACT", fmt.Sprintf("Unable to extract readable content from %q: %v", base.String(), err))
+		return false
+	}
+	if len(content) < settings.ExtractMinLength {
+		warnFn("DLWARN-0107-EXTRACTSHORT", fmt.Sprintf("Readable content of %q is only %d characters, keeping the existing body", base.String(), len(content)))
+		return false
+	}
+	bookmark.Body = model.ContentBodyText(content)
+	return true
+}
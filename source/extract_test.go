@@ -0,0 +1,199 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+
+	"github.com/lectio/graph/model"
+)
+
+const testArticlePage = `<html><head><title>Interoperability</title><script>var tracking = true;</script></head><body>
+<header><h1>Example Health News</h1></header>
+<nav><ul><li><a href="/">Home</a></li><li><a href="/about">About</a></li></ul></nav>
+<div class="sidebar"><p>Subscribe to our newsletter, follow us everywhere, and share this story.</p></div>
+<article>
+  <h1>Interoperability</h1>
+  <p>Health systems exchange records using standards, which makes interoperability possible, practical, and safe.</p>
+  <p>FHIR resources describe patients, encounters, and observations, so that <a href="/apps">applications</a> can share them.</p>
+</article>
+<div class="comments"><p>Great post, thanks for writing this, it was very helpful, and I shared it.</p></div>
+<footer><p>Copyright 2019, Example Health News, all rights reserved, and then some.</p></footer>
+</body></html>`
+
+const testArticleMarkdown = "# Interoperability\n\n" +
+	"Health systems exchange records using standards, which makes interoperability possible, practical, and safe.\n\n" +
+	"FHIR resources describe patients, encounters, and observations, so that [applications](https://example.com/apps) can share them."
+
+func parseTestHTML(t *testing.T, page string) *html.Node {
+	t.Helper()
+	doc, err := html.Parse(strings.NewReader(page))
+	if err != nil {
+		t.Fatalf("unable to parse HTML: %v", err)
+	}
+	return doc
+}
+
+func TestReadableContent(t *testing.T) {
+	base, _ := url.Parse("https://example.com/articles/interoperability.html")
+	tests := []struct {
+		name    string
+		page    string
+		want    string
+		wantErr bool
+	}{
+		{"article with navigation, sidebar, comments and footer", testArticlePage, testArticleMarkdown, false},
+		{"content in a div", `<html><body><div id="menu"><a href="/">Home</a></div><div class="post-content">
+			<p>Standards such as HL7 and FHIR, maintained by their communities, let health systems share records.</p>
+			</div></body></html>`,
+			"Standards such as HL7 and FHIR, maintained by their communities, let health systems share records.", false},
+		{"no readable content", `<html><body><p>Too short.</p></body></html>`, "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ReadableContent(parseTestHTML(t, test.page), base)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	base, _ := url.Parse("https://example.com/articles/story.html")
+	tests := []struct {
+		name     string
+		fragment string
+		want     string
+	}{
+		{"heading with a link", `<h2>About <a href="/fhir">FHIR</a></h2>`, "## About [FHIR](https://example.com/fhir)"},
+		{"emphasis with a link", `<p><strong>Read <a href="spec.html">the spec</a></strong> and <em>more</em>.</p>`,
+			"**Read [the spec](https://example.com/articles/spec.html)** and _more_."},
+		{"inline code", `<p>Call <code>Get()</code> or <code><a href="/docs/Put">Put()</a></code>.</p>`,
+			"Call `Get()` or [`Put()`](https://example.com/docs/Put)."},
+		{"code block", "<pre><code>func main() {\n    fmt.Println(\"hi\")\n}</code></pre>",
+			"```\nfunc main() {\n    fmt.Println(\"hi\")\n}\n```"},
+		{"nested lists", `<ul>
+			<li>First</li>
+			<li>Second
+				<ul><li>Nested <a href="nested">link</a></li><li>Other</li></ul>
+			</li>
+		</ul>
+		<ol><li>One</li><li>Two<ol><li>Deep</li></ol></li></ol>`,
+			"- First\n- Second\n  - Nested [link](https://example.com/articles/nested)\n  - Other\n\n1. One\n2. Two\n   1. Deep"},
+		{"relative links and images", `<p><a href="../about">About</a> <img src="/img/a.png" alt="A"> <img src="data:image/png;base64,AAAA">` +
+			`<a href="#top">Top</a> <a href="javascript:void(0)">Script</a> <a href="other"><img src="b.png" alt="B"></a></p>`,
+			"[About](https://example.com/about) ![A](https://example.com/img/a.png) Top Script [![B](https://example.com/articles/b.png)](https://example.com/articles/other)"},
+		{"quoted list", `<blockquote><p>Quoted</p><ul><li>a<ul><li>b</li></ul></li></ul></blockquote>`, "> Quoted\n>\n> - a\n>   - b"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var md strings.Builder
+			writeMarkdown(&md, parseTestHTML(t, "<html><body>"+test.fragment+"</body></html>"), base)
+			if got := tidyMarkdown(md.String()); got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestExtractBodyWanted(t *testing.T) {
+	tests := []struct {
+		policy model.ContentBodyExtractPolicy
+		body   model.ContentBodyText
+		want   bool
+	}{
+		{model.ContentBodyExtractPolicyNever, "", false},
+		{model.ContentBodyExtractPolicyExtractIfEmpty, " \n", true},
+		{model.ContentBodyExtractPolicyExtractIfEmpty, "The source's body", false},
+		{model.ContentBodyExtractPolicyAlwaysExtract, "The source's body", true},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s %q", test.policy, test.body), func(t *testing.T) {
+			bookmark := &model.Bookmark{Body: test.body}
+			if got := extractBodyWanted(bookmark, &model.ContentBodySettings{ExtractPolicy: test.policy}); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestExtractBody(t *testing.T) {
+	base, _ := url.Parse("https://example.com/articles/interoperability.html")
+	tests := []struct {
+		name      string
+		page      string
+		minLength int
+		want      model.ContentBodyText
+		wantWarn  string
+	}{
+		{"extracted", testArticlePage, 100, testArticleMarkdown, ""},
+		{"shorter than the minimum length", testArticlePage, 10000, "The source's body", "DLWARN-0107-EXTRACTSHORT"},
+		{"no readable content", `<html><body><p>Too short.</p></body></html>`, 0, "The source's body", "DLWARN-0106-EXTRACT"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bookmark := &model.Bookmark{Body: "The source's body"}
+			var warnings []string
+			extracted := extractBody(bookmark, parseTestHTML(t, test.page), base, &model.ContentBodySettings{ExtractMinLength: test.minLength},
+				func(code, message string) { warnings = append(warnings, code) })
+			if extracted != (len(test.wantWarn) == 0) || bookmark.Body != test.want {
+				t.Errorf("extracted %v with body %q, want %q", extracted, bookmark.Body, test.want)
+			}
+			if len(test.wantWarn) > 0 && (len(warnings) != 1 || warnings[0] != test.wantWarn) {
+				t.Errorf("got warnings %q, want %s", warnings, test.wantWarn)
+			}
+		})
+	}
+}
+
+func TestHarvestDestinationExtractsBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, testArticlePage)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name   string
+		policy model.ContentBodyExtractPolicy
+		body   model.ContentBodyText
+		want   model.ContentBodyText
+	}{
+		{"never", model.ContentBodyExtractPolicyNever, "", ""},
+		{"empty body extracted", model.ContentBodyExtractPolicyExtractIfEmpty, "", testArticleMarkdown},
+		{"existing body kept", model.ContentBodyExtractPolicyExtractIfEmpty, "The source's body", "The source's body"},
+		{"existing body replaced", model.ContentBodyExtractPolicyAlwaysExtract, "The source's body", testArticleMarkdown},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := newTestParams(t, server.URL+"/links.txt", func(config *model.Configuration, path model.SettingsPath) {
+				config.LinkLifecyleSettings(path).ParseMetaDataInLinkDestinationHTMLContent = false
+				config.ContentSettings(path).Body.ExtractPolicy = test.policy
+				config.ContentSettings(path).Body.ExtractMinLength = 100
+			})
+			lm := *params.LinksManager()
+			lt := new(linkTraversal)
+			lt.setTraversed()
+			lm.Context = withLinkTraversal(context.Background(), lt)
+
+			// relative links are resolved against the page the bookmark links to
+			finalURL, _ := url.Parse(server.URL + "/articles/interoperability.html")
+			bookmark := model.Bookmark{Body: test.body}
+			HarvestDestination(&bookmark, finalURL, lm, params.ContentSettings(), func(code, message string) {})
+			want := model.ContentBodyText(strings.Replace(string(test.want), "https://example.com", server.URL, -1))
+			if bookmark.Body != want {
+				t.Errorf("got body %q, want %q", bookmark.Body, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package source
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/lectio/graph/model"
+)
+
+// FeedSourceName is the registered name of the RSS and Atom feeds source
+const FeedSourceName = "Feed"
+
+func init() {
+	// only the conventional feed file names are matched, other .xml files such as sitemaps are not feeds
+	pattern, _ := model.MakeRegularExpression(`(?i)^https?\://.+(\.rss|\.atom|/(feed|rss|atom|index)\.xml|/feed|/rss|/atom)/?(\?.*)?$`)
+	MustRegister(&Registration{Name: FeedSourceName, URLPattern: pattern, Handler: FeedLinks, Priority: FallbackPriority})
+}
+
+// FeedEntry is a normalized RSS 2.0 item or Atom 1.0 entry
+type FeedEntry struct {
+	ID          string
+	Link        string
+	Title       string
+	Summary     string
+	Body        string
+	Categories  []string
+	PublishedAt string
+	UpdatedAt   string
+}
+
+// Feed is a normalized RSS 2.0 channel or Atom 1.0 feed
+type Feed struct {
+	Title   string
+	Entries []*FeedEntry
+}
+
+type rssDocument struct {
+	Channel struct {
+		Title string    `xml:"title"`
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+}
+
+type rssItem struct {
+	GUID           string   `xml:"guid"`
+	Link           string   `xml:"link"`
+	Title          string   `xml:"title"`
+	Description    string   `xml:"description"`
+	ContentEncoded string   `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	Categories     []string `xml:"category"`
+	PubDate        string   `xml:"pubDate"`
+	DCDate         string   `xml:"http://purl.org/dc/elements/1.1/ date"`
+}
+
+type atomDocument struct {
+	Title   string      `xml:"title"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID    string `xml:"id"`
+	Links []struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+	} `xml:"link"`
+	Title      string `xml:"title"`
+	Summary    string `xml:"summary"`
+	Content    string `xml:"content"`
+	Categories []struct {
+		Term  string `xml:"term,attr"`
+		Label string `xml:"label,attr"`
+	} `xml:"category"`
+	Published string `xml:"published"`
+	Updated   string `xml:"updated"`
+}
+
+// ParseFeed detects whether content is an RSS 2.0 or Atom 1.0 document and normalizes its entries
+func ParseFeed(content []byte) (*Feed, error) {
+	var root struct {
+		XMLName xml.Name
+	}
+	if err := xml.Unmarshal(content, &root); err != nil {
+		return nil, fmt.Errorf("unable to parse feed: %v", err)
+	}
+
+	switch strings.ToLower(root.XMLName.Local) {
+	case "rss":
+		return parseRSS(content)
+	case "feed":
+		return parseAtom(content)
+	default:
+		return nil, fmt.Errorf("unable to parse feed: root element <%s> is neither RSS <rss> nor Atom <feed>", root.XMLName.Local)
+	}
+}
+
+func parseRSS(content []byte) (*Feed, error) {
+	var doc rssDocument
+	if err := xml.NewDecoder(bytes.NewReader(content)).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("unable to parse RSS feed: %v", err)
+	}
+
+	result := &Feed{Title: strings.TrimSpace(doc.Channel.Title)}
+	for _, item := range doc.Channel.Items {
+		entry := &FeedEntry{
+			ID:          strings.TrimSpace(item.GUID),
+			Link:        strings.TrimSpace(item.Link),
+			Title:       strings.TrimSpace(item.Title),
+			Summary:     strings.TrimSpace(item.Description),
+			Body:        strings.TrimSpace(item.ContentEncoded),
+			PublishedAt: strings.TrimSpace(item.PubDate),
+			UpdatedAt:   strings.TrimSpace(item.DCDate)}
+		if len(entry.Link) == 0 && strings.HasPrefix(entry.ID, "http") {
+			entry.Link = entry.ID
+		}
+		if len(entry.PublishedAt) == 0 {
+			entry.PublishedAt = entry.UpdatedAt
+		}
+		for _, category := range item.Categories {
+			if category = strings.TrimSpace(category); len(category) > 0 {
+				entry.Categories = append(entry.Categories, category)
+			}
+		}
+		result.Entries = append(result.Entries, entry)
+	}
+	return result, nil
+}
+
+func parseAtom(content []byte) (*Feed, error) {
+	var doc atomDocument
+	if err := xml.NewDecoder(bytes.NewReader(content)).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("unable to parse Atom feed: %v", err)
+	}
+
+	result := &Feed{Title: strings.TrimSpace(doc.Title)}
+	for _, item := range doc.Entries {
+		entry := &FeedEntry{
+			ID:          strings.TrimSpace(item.ID),
+			Title:       strings.TrimSpace(item.Title),
+			Summary:     strings.TrimSpace(item.Summary),
+			Body:        strings.TrimSpace(item.Content),
+			PublishedAt: strings.TrimSpace(item.Published),
+			UpdatedAt:   strings.TrimSpace(item.Updated)}
+		for _, link := range item.Links {
+			if link.Rel == "" || link.Rel == "alternate" {
+				entry.Link = strings.TrimSpace(link.Href)
+				break
+			}
+		}
+		if len(entry.Link) == 0 && len(item.Links) > 0 {
+			entry.Link = strings.TrimSpace(item.Links[0].Href)
+		}
+		for _, category := range item.Categories {
+			name := category.Label
+			if len(name) == 0 {
+				name = category.Term
+			}
+			if name = strings.TrimSpace(name); len(name) > 0 {
+				entry.Categories = append(entry.Categories, name)
+			}
+		}
+		result.Entries = append(result.Entries, entry)
+	}
+	return result, nil
+}
+
+// NewBookmarkFromFeedEntry uses the FeedEntry to create a model.Bookmark
+func NewBookmarkFromFeedEntry(entry *FeedEntry, lm *LinksManager, cs *model.ContentSettings, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+	bookmark := model.Bookmark{
+		Link:       model.BookmarkLink{OriginalURLText: model.URLText(entry.Link)},
+		Title:      model.ContentTitleText(entry.Title),
+		Summary:    model.ContentSummaryText(entry.Summary),
+		Body:       model.ContentBodyText(entry.Body),
+		Properties: model.MakeProperties()}
+
+	if !FinalizeBookmark(&bookmark, lm, cs, errorFn, warnFn) {
+		return nil
+	}
+
+	if len(entry.Categories) > 0 {
+		bookmark.Taxonomies = append(bookmark.Taxonomies, cs.Taxonomies.TagsTaxonomy("categories", entry.Categories))
+	}
+
+	if len(entry.ID) > 0 {
+		bookmark.Properties.Add("feed.entryID", entry.ID)
+	}
+	if len(entry.PublishedAt) > 0 {
+		bookmark.Properties.Add("feed.publishedAt", entry.PublishedAt)
+	}
+	if len(entry.UpdatedAt) > 0 {
+		bookmark.Properties.Add("feed.updatedAt", entry.UpdatedAt)
+	}
+
+	return &bookmark
+}
+
+// FeedLinks returns a collection of harvested links from an RSS 2.0 or Atom 1.0 feed
+func FeedLinks(params LinksAPIHandlerParams) (*model.Bookmarks, error) {
+	source, ok := params.Source().(*model.BookmarksAPISource)
+	if !ok {
+		return nil, fmt.Errorf("Source is %+v, source.FeedLinks requires a model.BookmarksAPISource", params.Source())
+	}
+
+	lm := params.LinksManager()
+	cs := params.ContentSettings()
+	harvester := NewBookmarksHarvester(params, source)
+
+	content, readErr := ReadSourceContent(params, string(source.APIEndpoint))
+	if readErr != nil {
+		harvester.AddError(string(source.APIEndpoint), "FEEDERR-0001-READ", readErr.Error())
+		return harvester.Bookmarks, nil
+	}
+
+	feed, parseErr := ParseFeed(content)
+	if parseErr != nil {
+		harvester.AddError(string(source.APIEndpoint), "FEEDERR-0002-PARSE", parseErr.Error())
+		return harvester.Bookmarks, nil
+	}
+
+	return harvester.Harvest(len(feed.Entries),
+		func(index int) string {
+			return fmt.Sprintf("[%s] Feed entry %d %q", source.APIEndpoint, index, feed.Entries[index].Link)
+		},
+		func(index int, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+			return NewBookmarkFromFeedEntry(feed.Entries[index], lm, cs, errorFn, warnFn)
+		}), nil
+}
@@ -0,0 +1,94 @@
+package source
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/lectio/graph/model"
+)
+
+func TestFeedLinks(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+
+	type entry struct {
+		link        string
+		title       string
+		categories  []string
+		entryID     string
+		publishedAt string
+		updatedAt   string
+	}
+	tests := []struct {
+		name string
+		path string
+		want []entry
+	}{
+		{"RSS 2.0", "/feed.xml", []entry{
+			{"https://example.com/posts/first", "First Post", []string{"Health IT", "Health IT/Interoperability"},
+				"https://example.com/posts/first", "Mon, 02 Sep 2019 10:00:00 GMT", ""},
+			{"https://example.com/posts/second", "Second Post", nil,
+				"https://example.com/posts/second", "2019-09-03T10:00:00Z", "2019-09-03T10:00:00Z"},
+		}},
+		{"Atom 1.0", "/atom.xml", []entry{
+			{"https://example.com/entries/first", "First Entry", []string{"Go", "testing"},
+				"tag:example.com,2019:first", "2019-09-02T10:00:00Z", "2019-09-04T10:00:00Z"},
+			{"https://example.com/entries/second", "Second Entry", nil,
+				"tag:example.com,2019:second", "", "2019-09-05T10:00:00Z"},
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := newTestParams(t, server.URL+test.path, nil)
+			bookmarks, err := FeedLinks(params)
+			if err != nil {
+				t.Fatalf("FeedLinks returned %v", err)
+			}
+			if len(bookmarks.Activities.Errors) > 0 {
+				t.Fatalf("FeedLinks reported errors: %+v", bookmarks.Activities.Errors)
+			}
+			if len(bookmarks.Content) != len(test.want) {
+				t.Fatalf("FeedLinks returned %d bookmarks, want %d", len(bookmarks.Content), len(test.want))
+			}
+			for i, want := range test.want {
+				bookmark := bookmarks.Content[i]
+				if got := string(bookmark.Link.OriginalURLText); got != want.link {
+					t.Errorf("bookmark %d link = %q, want %q", i, got, want.link)
+				}
+				if got := string(bookmark.Title); got != want.title {
+					t.Errorf("bookmark %d title = %q, want %q", i, got, want.title)
+				}
+				if got := taxa(bookmark, "categories"); !reflect.DeepEqual(got, want.categories) {
+					t.Errorf("bookmark %d categories = %q, want %q", i, got, want.categories)
+				}
+				properties := map[model.PropertyName]string{"feed.entryID": want.entryID, "feed.publishedAt": want.publishedAt, "feed.updatedAt": want.updatedAt}
+				for name, value := range properties {
+					got, _ := bookmark.Properties.Get(name)
+					if got == nil {
+						got = ""
+					}
+					if got != value {
+						t.Errorf("bookmark %d %s = %q, want %q", i, name, got, value)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestParseFeedErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"not XML", "this is not a feed"},
+		{"sitemap", `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"><url><loc>https://example.com</loc></url></urlset>`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if feed, err := ParseFeed([]byte(test.content)); err == nil {
+				t.Errorf("ParseFeed returned %+v, want an error", feed)
+			}
+		})
+	}
+}
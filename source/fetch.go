@@ -0,0 +1,127 @@
+package source
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// stdin is where standard input sources are read from
+var stdin io.Reader = os.Stdin
+
+// ReadSourceContent retrieves the raw content of a source's API endpoint; file:// URLs are read from the local
+// file system, as long as they're under the SourceSettings LocalFilesRootPath, stdin:// (or "-") is read from
+// standard input, as long as the SourceSettings AllowStdin, and all other URLs are retrieved using the links
+// manager's HTTP client
+func ReadSourceContent(params LinksAPIHandlerParams, urlText string) ([]byte, error) {
+	if isFileURL(urlText) {
+		return readFileURL(urlText, params.SourceSettings().LocalFilesRootPath)
+	}
+	if isStdinURL(urlText) {
+		if !params.SourceSettings().AllowStdin {
+			return nil, fmt.Errorf("unable to read standard input: it is not allowed, SourceSettings AllowStdin is false")
+		}
+		content, readErr := ioutil.ReadAll(stdin)
+		if readErr != nil {
+			return nil, fmt.Errorf("unable to read standard input: %v", readErr)
+		}
+		return content, nil
+	}
+
+	req, reqErr := http.NewRequest(http.MethodGet, urlText, nil)
+	if reqErr != nil {
+		return nil, fmt.Errorf("unable to create request for %q: %v", urlText, reqErr)
+	}
+	req = req.WithContext(params.Context())
+	req.Header.Set("User-Agent", params.HTTPClientSettings().UserAgent)
+
+	resp, getErr := params.LinksManager().HTTPClient().Do(req)
+	if getErr != nil {
+		return nil, fmt.Errorf("unable to retrieve %q: %v", urlText, getErr)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to retrieve %q: HTTP status %d", urlText, resp.StatusCode)
+	}
+
+	body, readErr := ioutil.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("unable to read %q: %v", urlText, readErr)
+	}
+	return body, nil
+}
+
+// isFileURL returns true if urlText is a file:// URL, which ReadSourceContent reads from the local file system
+func isFileURL(urlText string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(urlText)), "file://")
+}
+
+// isStdinURL returns true if urlText is stdin:// or "-", which ReadSourceContent reads from standard input
+func isStdinURL(urlText string) bool {
+	urlText = strings.TrimSpace(urlText)
+	return urlText == "-" || strings.HasPrefix(strings.ToLower(urlText), "stdin://")
+}
+
+// isLocalURL returns true if ReadSourceContent reads urlText from the local machine rather than the network
+func isLocalURL(urlText string) bool {
+	return isFileURL(urlText) || isStdinURL(urlText)
+}
+
+// readFileURL reads the file at urlText, which sources may be given by any GraphQL client, so it refuses files
+// outside of rootPath, including those reached through symbolic links
+func readFileURL(urlText string, rootPath string) ([]byte, error) {
+	fileURL, parseErr := url.Parse(urlText)
+	if parseErr != nil {
+		return nil, fmt.Errorf("unable to parse file URL %q: %v", urlText, parseErr)
+	}
+
+	// file://relative/path puts the first path segment into Host, so put it back
+	host := fileURL.Host
+	if host == "localhost" {
+		host = ""
+	}
+	path := filepath.FromSlash(host + fileURL.Path)
+	if allowedErr := localFileAllowed(path, rootPath); allowedErr != nil {
+		return nil, allowedErr
+	}
+	content, readErr := ioutil.ReadFile(path)
+	if readErr != nil {
+		return nil, fmt.Errorf("unable to read file %q: %v", path, readErr)
+	}
+	return content, nil
+}
+
+// localFileAllowed returns an error unless path, once made absolute with its symbolic links resolved, is rootPath
+// or inside it
+func localFileAllowed(path string, rootPath string) error {
+	if len(rootPath) == 0 {
+		return fmt.Errorf("unable to read file %q: local files are not allowed, SourceSettings LocalFilesRootPath is empty", path)
+	}
+	root, rootErr := resolvedPath(rootPath)
+	if rootErr != nil {
+		return fmt.Errorf("unable to resolve LocalFilesRootPath %q: %v", rootPath, rootErr)
+	}
+	resolved, pathErr := resolvedPath(path)
+	if pathErr != nil {
+		return fmt.Errorf("unable to read file %q: %v", path, pathErr)
+	}
+	rel, relErr := filepath.Rel(root, resolved)
+	if relErr != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("unable to read file %q: it is outside of LocalFilesRootPath %q", path, rootPath)
+	}
+	return nil
+}
+
+func resolvedPath(path string) (string, error) {
+	abs, absErr := filepath.Abs(path)
+	if absErr != nil {
+		return "", absErr
+	}
+	return filepath.EvalSymlinks(abs)
+}
@@ -0,0 +1,64 @@
+package source
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadFileURL(t *testing.T) {
+	dir, dirErr := ioutil.TempDir("", "lectio_fetch_test_")
+	if dirErr != nil {
+		t.Fatalf("unable to create temp dir: %v", dirErr)
+	}
+	defer os.RemoveAll(dir)
+
+	root := filepath.Join(dir, "site")
+	outside := filepath.Join(dir, "secret.txt")
+	mustWrite := func(path string) {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(path), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mustWrite(filepath.Join(root, "links.txt"))
+	mustWrite(filepath.Join(root, "nested", "links.txt"))
+	mustWrite(filepath.Join(dir, "site-other", "links.txt"))
+	mustWrite(outside)
+	symlinkErr := os.Symlink(outside, filepath.Join(root, "escape.txt"))
+
+	fileURL := func(path string) string {
+		return "file://" + filepath.ToSlash(path)
+	}
+	tests := []struct {
+		name     string
+		urlText  string
+		rootPath string
+		allowed  bool
+	}{
+		{"file in root", fileURL(filepath.Join(root, "links.txt")), root, true},
+		{"file in subdirectory", fileURL(filepath.Join(root, "nested", "links.txt")), root, true},
+		{"file outside root", fileURL(outside), root, false},
+		{"parent directory traversal", fileURL(root + "/../secret.txt"), root, false},
+		{"sibling with the root as prefix", fileURL(filepath.Join(dir, "site-other", "links.txt")), root, false},
+		{"symbolic link out of root", fileURL(filepath.Join(root, "escape.txt")), root, false},
+		{"no root", fileURL(filepath.Join(root, "links.txt")), "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.name == "symbolic link out of root" && symlinkErr != nil {
+				t.Skipf("unable to create symbolic link: %v", symlinkErr)
+			}
+			_, err := readFileURL(test.urlText, test.rootPath)
+			if test.allowed && err != nil {
+				t.Errorf("readFileURL(%q) returned %v, want it allowed", test.urlText, err)
+			}
+			if !test.allowed && err == nil {
+				t.Errorf("readFileURL(%q) succeeded, want it refused", test.urlText)
+			}
+		})
+	}
+}
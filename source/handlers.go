@@ -1,17 +1,23 @@
 package source
 
 import (
+	"context"
+
 	"github.com/lectio/graph/model"
 	"github.com/lectio/graph/observe"
 )
 
 // LinksAPIHandlerParams defines the parameters for the LinksAPIHandler function
 type LinksAPIHandlerParams interface {
+	Context() context.Context
 	Source() model.APISource
+	SettingsPath() model.SettingsPath
 	ContentSettings() *model.ContentSettings
+	SourceSettings() *model.SourceSettings
 	HTTPClientSettings() *model.HTTPClientSettings
 	LinksManager() *LinksManager
 	Asynch() bool
+	SyncWatermark() *SyncWatermark
 	ProgressReporter() observe.ProgressReporter
 }
 
@@ -19,40 +25,72 @@ type LinksAPIHandlerParams interface {
 type LinksAPIHandlerFunc func(LinksAPIHandlerParams) (*model.Bookmarks, error)
 
 type defaultLinksAPIHandlerParams struct {
+	ctx              context.Context
 	source           model.APISource
+	path             model.SettingsPath
 	hcs              *model.HTTPClientSettings
 	lm               *LinksManager
 	cs               *model.ContentSettings
+	ss               *model.SourceSettings
 	os               *model.ObservationSettings
 	progressReporter observe.ProgressReporter
+	watermark        *SyncWatermark
 }
 
-// NewLinksAPIHandlerParams returns a default parameters for common use cases
-func NewLinksAPIHandlerParams(config *model.Configuration, source model.APISource, path model.SettingsPath) (LinksAPIHandlerParams, error) {
+// NewLinksAPIHandlerParams returns a default parameters for common use cases; cancelling ctx stops the harvest
+func NewLinksAPIHandlerParams(ctx context.Context, config *model.Configuration, source model.APISource, path model.SettingsPath) (LinksAPIHandlerParams, error) {
 	result := new(defaultLinksAPIHandlerParams)
 
+	result.ctx = ctx
 	result.source = source
+	result.path = path
 	result.hcs = config.HTTPClientSettings(path)
 
 	lls := config.LinkLifecyleSettings(path)
 	httpClient := config.HTTPClient(path)
-	result.lm = &LinksManager{Config: config, LinkSettings: lls, Client: httpClient}
+	result.lm = &LinksManager{Context: ctx, Config: config, LinkSettings: lls, ClientSettings: result.hcs, Pool: SharedHarvestPool(lls), Robots: SharedRobotsCache(lls)}
+	result.lm.Client = NewRedirectChainClient(NewRetryClient(httpClient, lls, func() ActivityReporter { return result.lm.Activities }))
 
 	result.cs = config.ContentSettings(path)
+	result.ss = config.SourceSettings(path)
 	result.os = config.ObservationSettings(path)
 	result.progressReporter = result.os.ProgressReporter()
 
 	return result, nil
 }
 
+// NewIncrementalLinksAPIHandlerParams returns parameters for handlers that should skip items which have not
+// changed since the watermark
+func NewIncrementalLinksAPIHandlerParams(ctx context.Context, config *model.Configuration, source model.APISource, path model.SettingsPath, watermark *SyncWatermark) (LinksAPIHandlerParams, error) {
+	params, err := NewLinksAPIHandlerParams(ctx, config, source, path)
+	if err != nil {
+		return params, err
+	}
+	result := params.(*defaultLinksAPIHandlerParams)
+	result.watermark = watermark
+	return result, nil
+}
+
+func (p defaultLinksAPIHandlerParams) Context() context.Context {
+	return p.ctx
+}
+
 func (p defaultLinksAPIHandlerParams) Source() model.APISource {
 	return p.source
 }
 
+func (p defaultLinksAPIHandlerParams) SettingsPath() model.SettingsPath {
+	return p.path
+}
+
 func (p defaultLinksAPIHandlerParams) ContentSettings() *model.ContentSettings {
 	return p.cs
 }
 
+func (p defaultLinksAPIHandlerParams) SourceSettings() *model.SourceSettings {
+	return p.ss
+}
+
 func (p defaultLinksAPIHandlerParams) HTTPClientSettings() *model.HTTPClientSettings {
 	return p.hcs
 }
@@ -63,7 +101,11 @@ func (p defaultLinksAPIHandlerParams) LinksManager() *LinksManager {
 
 func (p defaultLinksAPIHandlerParams) Asynch() bool {
 	// link traversals can be slow so do it asynchronously; if we're not traversing links no need for extra work
-	return p.lm.LinkSettings.TraverseLinks
+	return p.lm.LinkSettings.TraversesAnyLinks()
+}
+
+func (p defaultLinksAPIHandlerParams) SyncWatermark() *SyncWatermark {
+	return p.watermark
 }
 
 func (p defaultLinksAPIHandlerParams) ProgressReporter() observe.ProgressReporter {
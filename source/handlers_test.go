@@ -0,0 +1,67 @@
+package source
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lectio/graph/model"
+)
+
+// newTestServer serves the files in testdata, as the sources would find them on the web
+func newTestServer() *httptest.Server {
+	return httptest.NewServer(http.FileServer(http.Dir("testdata")))
+}
+
+// newTestParams returns the default handler params for urlText without link traversal or progress reporting and
+// with an HTTP client that is allowed to reach the loopback address test servers listen on; configure, when not
+// nil, can change the settings before the params are created
+func newTestParams(t *testing.T, urlText string, configure func(*model.Configuration, model.SettingsPath)) LinksAPIHandlerParams {
+	t.Helper()
+	return newTestParamsWithContext(t, context.Background(), urlText, configure)
+}
+
+// newTestParamsWithContext is like newTestParams but harvests with ctx
+func newTestParamsWithContext(t *testing.T, ctx context.Context, urlText string, configure func(*model.Configuration, model.SettingsPath)) LinksAPIHandlerParams {
+	t.Helper()
+	config, configErr := model.MakeConfiguration()
+	if configErr != nil {
+		t.Fatalf("unable to create configuration: %v", configErr)
+	}
+	path := model.SettingsPath(model.DefaultSettingsStoreName)
+	config.LinkLifecyleSettings(path).TraverseLinks = false
+	config.ObservationSettings(path).ProgressReporterType = model.ProgressReporterTypeSilent
+	if configure != nil {
+		configure(config, path)
+	}
+
+	apiSource := &model.BookmarksAPISource{Name: "Test", APIEndpoint: model.URLText(urlText)}
+	params, paramsErr := NewLinksAPIHandlerParams(ctx, config, apiSource, path)
+	if paramsErr != nil {
+		t.Fatalf("unable to create params: %v", paramsErr)
+	}
+	lm := params.LinksManager()
+	lm.Client = NewRedirectChainClient(NewRetryClient(&http.Client{}, lm.LinkSettings, func() ActivityReporter { return lm.Activities }))
+	return params
+}
+
+// taxa returns the taxa of the bookmark's taxonomy called name, hierarchical taxonomies as paths
+func taxa(bookmark model.Bookmark, name model.TaxonomyName) []string {
+	var result []string
+	for _, taxonomy := range bookmark.Taxonomies {
+		switch t := taxonomy.(type) {
+		case model.FlatTaxonomy:
+			if t.Name == name {
+				for _, taxon := range t.Taxa {
+					result = append(result, string(taxon))
+				}
+			}
+		case model.HiearchicalTaxonomy:
+			if t.Name == name {
+				result = append(result, t.Paths("/")...)
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,319 @@
+package source
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	ll "github.com/lectio/link"
+
+	"github.com/lectio/graph/model"
+)
+
+// BookmarkItemFunc creates a model.Bookmark for the item at index, returning nil if the item should be skipped
+type BookmarkItemFunc func(index int, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark
+
+// BookmarksHarvester collects bookmarks and activities from a source, synchronously or asynchronously
+type BookmarksHarvester struct {
+	Bookmarks *model.Bookmarks
+	params    LinksAPIHandlerParams
+	source    *model.BookmarksAPISource
+	mutex     sync.Mutex
+}
+
+// NewBookmarksHarvester creates an empty collection of bookmarks for the given source; retries and circuit
+// breaker trips of the params' LinksManager are recorded in the collection's activities
+func NewBookmarksHarvester(params LinksAPIHandlerParams, source *model.BookmarksAPISource) *BookmarksHarvester {
+	result := new(BookmarksHarvester)
+	result.params = params
+	result.source = source
+	result.Bookmarks = &model.Bookmarks{}
+	result.Bookmarks.Source = *source
+	result.Bookmarks.Activities = model.Activities{}
+	params.LinksManager().Activities = result
+	return result
+}
+
+// AddError records an error in the bookmarks collection's activities
+func (h *BookmarksHarvester) AddError(context, code, message string) {
+	h.mutex.Lock()
+	h.Bookmarks.Activities.AddError(context, code, message)
+	h.mutex.Unlock()
+}
+
+// AddWarning records a warning in the bookmarks collection's activities
+func (h *BookmarksHarvester) AddWarning(context, code, message string) {
+	h.mutex.Lock()
+	h.Bookmarks.Activities.AddWarning(context, code, message)
+	h.mutex.Unlock()
+}
+
+// Add appends a bookmark to the collection
+func (h *BookmarksHarvester) Add(bookmark *model.Bookmark) {
+	h.mutex.Lock()
+	h.Bookmarks.Content = append(h.Bookmarks.Content, *bookmark)
+	h.mutex.Unlock()
+}
+
+// SetSourceLinks records the original links of every item that is still in the source, whether or not they're
+// harvested; only sources which can list all of their items should call it since any generated content whose
+// link is missing from links is treated as deleted from the source
+func (h *BookmarksHarvester) SetSourceLinks(links []model.URLText) {
+	h.mutex.Lock()
+	h.Bookmarks.SourceLinks = links
+	h.Bookmarks.SourceLinksComplete = true
+	h.mutex.Unlock()
+}
+
+// Cancelled returns true, recording the cancellation, if the harvest's context was cancelled or its deadline
+// passed; sources check it around work that can't be cancelled itself
+func (h *BookmarksHarvester) Cancelled() bool {
+	err := h.params.Context().Err()
+	if err == nil {
+		return false
+	}
+	h.mutex.Lock()
+	h.Bookmarks.Activities.AddHistory(&model.ActivityLog{
+		Context: model.ActivityContext(h.source.APIEndpoint),
+		Code:    "HARVEST-CANCELLED",
+		Message: model.ActivityHumanMessage(fmt.Sprintf("Harvest of %s Links cancelled: %v", h.source.Name, err))})
+	h.mutex.Unlock()
+	return true
+}
+
+// Harvest calls createFn for each of the count items and reports progress; issueContext describes item at index
+func (h *BookmarksHarvester) Harvest(count int, issueContext func(index int) string, createFn BookmarkItemFunc) *model.Bookmarks {
+	return h.HarvestChanged(count, nil, issueContext, createFn)
+}
+
+// HarvestChanged is like Harvest but, when the params have a SyncWatermark, it skips the items whose
+// updatedAt time shows they have not changed since the watermark and tells the watermark when the harvested
+// items were updated, or that they failed
+func (h *BookmarksHarvester) HarvestChanged(count int, updatedAt func(index int) time.Time, issueContext func(index int) string, createFn BookmarkItemFunc) *model.Bookmarks {
+	ctx := h.params.Context()
+	pr := h.params.ProgressReporter()
+	watermark := h.params.SyncWatermark()
+
+	indexes := make([]int, 0, count)
+	for index := 0; index < count; index++ {
+		if watermark != nil && updatedAt != nil && !watermark.Changed(updatedAt(index)) {
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+	if skipped := count - len(indexes); skipped > 0 {
+		h.Bookmarks.Activities.AddHistory(&model.ActivityLog{
+			Context: model.ActivityContext(h.source.APIEndpoint),
+			Code:    "HARVEST-SKIPPED-UNCHANGED",
+			Message: model.ActivityHumanMessage(fmt.Sprintf("Skipped %d of %d %s Links unchanged since %s", skipped, count, h.source.Name, watermark.Since.Format(time.RFC3339)))})
+	}
+
+	// bookmarks are kept by position so that the output follows the source order, not the completion order
+	created := make([]*model.Bookmark, len(indexes))
+	cs := h.params.ContentSettings()
+	createBookmark := func(position int) {
+		if ctx.Err() != nil {
+			return
+		}
+		index := indexes[position]
+		context := issueContext(index)
+		failed := false
+		created[position] = createFn(index,
+			func(code, message string) {
+				failed = true
+				h.AddError(context, code, message)
+			},
+			func(code, message string) {
+				h.AddWarning(context, code, message)
+			})
+		if watermark != nil && updatedAt != nil {
+			if failed {
+				watermark.failed()
+			} else if created[position] != nil {
+				watermark.harvested(created[position].ID, updatedAt(index))
+			}
+		}
+		if created[position] != nil {
+			if err := created[position].ExtractKeywords(&cs.Keywords, cs.Taxonomies); err != nil {
+				h.AddWarning(context, "NLPWARN-0101-KEYWORDS", fmt.Sprintf("Unable to extract keywords: %v", err))
+			}
+			// categorization rules run once the source's own taxa, the extracted body and the metadata are known
+			created[position].Categorize(cs.CategorizationRules, cs.Taxonomies)
+			for _, taxonomy := range created[position].Taxonomies {
+				name, unknown := cs.Taxonomies.UnknownTaxa(taxonomy)
+				for _, taxon := range unknown {
+					h.AddWarning(context, "TAXWARN-0101-UNKNOWNTAXON", fmt.Sprintf("Taxon %q is not in the %q taxonomy's vocabulary", taxon, name))
+				}
+			}
+		}
+	}
+
+	pr.StartReportableActivity(fmt.Sprintf("Importing %d %s Links from %q", len(indexes), h.source.Name, h.source.APIEndpoint), len(indexes))
+	if h.params.Asynch() {
+		pool := h.params.LinksManager().Pool
+		positions := make(chan int)
+		completed := make(chan int)
+		var wg sync.WaitGroup
+		for worker := 0; worker < pool.Workers(len(indexes)); worker++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for position := range positions {
+					createBookmark(position)
+					completed <- position
+				}
+			}()
+		}
+		go func() {
+			for position := range indexes {
+				positions <- position
+			}
+			close(positions)
+		}()
+		go func() {
+			defer close(completed)
+			wg.Wait()
+		}()
+		for range completed {
+			pr.IncrementReportableActivityProgress()
+		}
+	} else {
+		for position := range indexes {
+			createBookmark(position)
+			pr.IncrementReportableActivityProgress()
+		}
+	}
+	for _, bookmark := range h.mergeDuplicates(created) {
+		h.Add(bookmark)
+	}
+	if ctx.Err() != nil {
+		h.Bookmarks.Activities.AddHistory(&model.ActivityLog{
+			Context: model.ActivityContext(h.source.APIEndpoint),
+			Code:    "HARVEST-CANCELLED",
+			Message: model.ActivityHumanMessage(fmt.Sprintf("Harvest of %s Links cancelled after %d of %d: %v", h.source.Name, len(h.Bookmarks.Content), len(indexes), ctx.Err()))})
+	}
+	pr.CompleteReportableActivityProgress(fmt.Sprintf("Imported %d of %d %s Links from %q", len(h.Bookmarks.Content), len(indexes), h.source.Name, h.source.APIEndpoint))
+	return h.Bookmarks
+}
+
+// mergeDuplicates merges the bookmarks with the same ID, because they resolved to the same canonical URL, into
+// the first of them and returns the remaining bookmarks in their original order
+func (h *BookmarksHarvester) mergeDuplicates(bookmarks []*model.Bookmark) []*model.Bookmark {
+	result := make([]*model.Bookmark, 0, len(bookmarks))
+	byID := make(map[string]*model.Bookmark, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		if bookmark == nil {
+			continue
+		}
+		existing, found := byID[bookmark.ID]
+		if !found || len(bookmark.ID) == 0 {
+			byID[bookmark.ID] = bookmark
+			result = append(result, bookmark)
+			continue
+		}
+		existing.Merge(bookmark)
+		resolvedURL := existing.Link.FinalURL
+		if existing.Link.CanonicalURL != nil {
+			resolvedURL = existing.Link.CanonicalURL
+		}
+		h.Bookmarks.Activities.AddHistory(&model.ActivityLog{
+			Context: model.ActivityContext(existing.Link.OriginalURLText),
+			Code:    "HARVEST-MERGED-DUPLICATE",
+			Message: model.ActivityHumanMessage(fmt.Sprintf("Merged %q into %q, both resolve to %q", bookmark.Link.OriginalURLText, existing.Link.OriginalURLText, resolvedURL.Text()))})
+	}
+	return result
+}
+
+// FinalizeBookmark applies the content settings edits to the bookmark and resolves its link, returning false
+// if the bookmark should be skipped
+func FinalizeBookmark(bookmark *model.Bookmark, lm *LinksManager, cs *model.ContentSettings, errorFn func(code, message string), warnFn func(code, message string)) bool {
+	bookmark.Title.Edit(bookmark, &cs.Title)
+	bookmark.Summary.Edit(bookmark, &cs.Summary)
+	bookmark.Body.Edit(bookmark, &cs.Body)
+
+	if bookmark.Link.OriginalURLText.IsEmpty() {
+		warnFn("DLWARN-0101-LINKEMPTY", "Empty link")
+		return false
+	}
+
+	// rewrites apply before the link is traversed so that the rewritten URL is the one that's retrieved
+	linkURLText := bookmark.Link.OriginalURLText
+	if originalURL, parseErr := url.Parse(string(linkURLText)); parseErr == nil && originalURL.IsAbs() {
+		rewrittenURL, rewrites := lm.RewriteURL(originalURL, warnFn)
+		if len(rewrites) > 0 {
+			if ignore, ignoreReason := lm.IgnoreLink(rewrittenURL); ignore {
+				warnFn("DLWARN-0100-IGNORE", fmt.Sprintf("Rewritten URL %q: %s", rewrittenURL.String(), ignoreReason))
+				return false
+			}
+			bookmark.Link.Rewrites = rewrites
+			linkURLText = model.URLText(rewrittenURL.String())
+		}
+	}
+
+	// the crawl-delay is waited out before the pool's slots are taken so that waiting doesn't hold them
+	if linkURL, parseErr := url.Parse(string(linkURLText)); parseErr == nil && lm.TraverseLinks(linkURL) {
+		lm.Robots.Wait(lm.Context, *lm, linkURL)
+	}
+	// the requests made while traversing are recorded by the client's RedirectTransport into the traversal
+	lt := new(linkTraversal)
+	traversal := *lm
+	traversal.Context = withLinkTraversal(lm.Context, lt)
+	// the link's slot is held until its destination is harvested from the page the traversal read
+	release, acquireErr := lm.Pool.Acquire(traversal.Context, string(linkURLText))
+	if acquireErr != nil {
+		// the harvest was cancelled while waiting for a slot, the harvester records the cancellation
+		return false
+	}
+	defer release()
+	link, linkErr := linkURLText.Link(traversal)
+	bookmark.Link.RedirectChain = lt.redirects()
+	if linkErr != nil || link == nil {
+		errorFn("DLERR-0101-LINKERR", fmt.Sprintf("Unable to create link.Link: %v", linkErr))
+		return false
+	}
+	managedLink, isManagedLink := link.(ll.ManagedLink)
+
+	if isManagedLink && managedLink.Issues() != nil {
+		managedLink.Issues().HandleIssues(
+			func(err ll.Issue) {
+				errorFn(string(err.IssueCode()), err.Issue())
+			},
+			func(warning ll.Issue) {
+				warnFn(string(warning.IssueCode()), warning.Issue())
+			})
+	}
+
+	finalURL, finalURLErr := link.FinalURL()
+	if finalURLErr != nil {
+		errorFn("DMERR-LINK_FINALURL", finalURLErr.Error())
+		return false
+	}
+
+	// this shouldnt occur because it should be caught by "issues" block above but, just in case...
+	if isManagedLink {
+		ignore, ignoreReason := managedLink.Ignore()
+		if ignore {
+			warnFn("DLWARN-0100-IGNORE", ignoreReason)
+			return false
+		}
+	}
+
+	bookmark.ID = lm.Config.ContentAddressableStorageHash(finalURL.String())
+	// attachments are saved under the URL they were downloaded from
+	for _, urlText := range []string{finalURL.String(), string(linkURLText)} {
+		if attachment, ok := lm.Attachments.Attachment(urlText); ok {
+			if bookmark.Properties == nil {
+				bookmark.Properties = model.MakeProperties()
+			}
+			bookmark.Properties.Add("link.attachment", attachment.FileName)
+			bookmark.Properties.Add("link.attachmentMediaType", attachment.MediaType)
+			break
+		}
+	}
+	bookmark.Link.IsValid = true
+	bookmark.Link.FinalURL = model.MakeURL(finalURL)
+
+	HarvestDestination(bookmark, finalURL, traversal, cs, warnFn)
+	return true
+}
@@ -0,0 +1,154 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/lectio/graph/model"
+)
+
+// hasActivity returns true if the bookmarks' activities recorded a history entry with code
+func hasActivity(bookmarks *model.Bookmarks, code model.ActivityCode) bool {
+	for _, activity := range bookmarks.Activities.History {
+		if log, ok := activity.(*model.ActivityLog); ok && log.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+func TestHarvestCancelled(t *testing.T) {
+	const count = 100
+	tests := []struct {
+		name   string
+		asynch bool
+	}{
+		{"synchronous", false},
+		{"asynchronous", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			params := newTestParamsWithContext(t, ctx, "https://example.com/links.json", func(config *model.Configuration, path model.SettingsPath) {
+				// traversing links is what makes the harvest asynchronous, the items here aren't traversed
+				config.LinkLifecyleSettings(path).TraverseLinks = test.asynch
+			})
+			if params.Asynch() != test.asynch {
+				t.Fatalf("harvest asynchronous = %v, want %v", params.Asynch(), test.asynch)
+			}
+			harvester := NewBookmarksHarvester(params, params.Source().(*model.BookmarksAPISource))
+			bookmarks := harvester.Harvest(count,
+				func(index int) string {
+					return fmt.Sprintf("item %d", index)
+				},
+				func(index int, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+					if index == 1 {
+						cancel()
+					}
+					return &model.Bookmark{ID: fmt.Sprintf("%d", index), Title: model.ContentTitleText(fmt.Sprintf("Item %d", index))}
+				})
+			if len(bookmarks.Content) == 0 || len(bookmarks.Content) == count {
+				t.Errorf("harvested %d of %d items, want the harvest to stop once cancelled", len(bookmarks.Content), count)
+			}
+			if !hasActivity(bookmarks, "HARVEST-CANCELLED") {
+				t.Errorf("cancellation wasn't recorded: %+v", bookmarks.Activities.History)
+			}
+		})
+	}
+}
+
+func TestDropmarkLinksCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	params := newTestParamsWithContext(t, ctx, "https://example.dropmark.com/1.json", nil)
+	bookmarks, err := DropmarkLinks(params)
+	if err != nil {
+		t.Fatalf("DropmarkLinks returned %v", err)
+	}
+	if !hasActivity(bookmarks, "HARVEST-CANCELLED") {
+		t.Errorf("cancellation wasn't recorded: %+v", bookmarks.Activities.History)
+	}
+	if len(bookmarks.Content) > 0 || bookmarks.SourceLinksComplete {
+		t.Errorf("cancelled harvest returned %d bookmarks and complete source links %v", len(bookmarks.Content), bookmarks.SourceLinksComplete)
+	}
+}
+
+func TestHarvestMergesDuplicates(t *testing.T) {
+	// both pages declare the same canonical URL, so their bookmarks are duplicates
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, testDestinationPage)
+	}))
+	defer server.Close()
+
+	params := newTestParams(t, server.URL+"/links.json", func(config *model.Configuration, path model.SettingsPath) {
+		config.LinkLifecyleSettings(path).ParseMetaDataInLinkDestinationHTMLContent = false
+	})
+	items := []struct {
+		path     string
+		tags     []model.TaxonName
+		property model.PropertyName
+	}{
+		{"/first", []model.TaxonName{"fhir", "interop"}, "first.property"},
+		{"/second", []model.TaxonName{"interop", "security"}, "second.property"},
+	}
+	harvester := NewBookmarksHarvester(params, params.Source().(*model.BookmarksAPISource))
+	bookmarks := harvester.Harvest(len(items),
+		func(index int) string {
+			return items[index].path
+		},
+		func(index int, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+			item := items[index]
+			lm := *params.LinksManager()
+			lt := new(linkTraversal)
+			lm.Context = withLinkTraversal(params.Context(), lt)
+
+			// the traversal reads the page, as link.TraverseLink does, through the client with the traversal's context
+			lt.setTraversed()
+			req, _ := http.NewRequest(http.MethodGet, server.URL+item.path, nil)
+			resp, err := lm.HTTPClient().Do(req.WithContext(lm.Context))
+			if err != nil {
+				t.Errorf("traversal of %s failed: %v", item.path, err)
+				return nil
+			}
+			ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			finalURL, _ := url.Parse(server.URL + item.path)
+			bookmark := &model.Bookmark{
+				ID:         lm.Config.ContentAddressableStorageHash(finalURL.String()),
+				Link:       model.BookmarkLink{OriginalURLText: model.URLText(finalURL.String()), FinalURL: model.MakeURL(finalURL)},
+				Taxonomies: []model.Taxonomy{model.FlatTaxonomy{Name: "tags", Taxa: item.tags}},
+				Properties: model.MakeProperties(),
+			}
+			bookmark.Properties.Add(item.property, item.path)
+			HarvestDestination(bookmark, finalURL, lm, params.ContentSettings(), warnFn)
+			return bookmark
+		})
+
+	if len(bookmarks.Content) != 1 {
+		t.Fatalf("got %d bookmarks, want the duplicates merged into 1", len(bookmarks.Content))
+	}
+	merged := bookmarks.Content[0]
+	if merged.Link.OriginalURLText != model.URLText(server.URL+"/first") {
+		t.Errorf("merged into %q, want the first bookmark", merged.Link.OriginalURLText)
+	}
+	if got, want := taxa(merged, "tags"), []string{"fhir", "interop", "security"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got tags %q, want %q", got, want)
+	}
+	for _, item := range items {
+		if value, found := merged.Properties.Get(item.property); !found || value != item.path {
+			t.Errorf("got property %s = %v, want %q", item.property, value, item.path)
+		}
+	}
+	if !hasActivity(bookmarks, "HARVEST-MERGED-DUPLICATE") {
+		t.Errorf("merge wasn't recorded: %+v", bookmarks.Activities.History)
+	}
+}
@@ -0,0 +1,61 @@
+package source
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/lectio/graph/model"
+)
+
+// JSONListSourceName is the registered name of the local JSON array of links source
+const JSONListSourceName = "JSONList"
+
+func init() {
+	pattern, _ := model.MakeRegularExpression(`(?i)^file\://.+\.json$`)
+	MustRegister(&Registration{Name: JSONListSourceName, URLPattern: pattern, Handler: NewListLinksHandler("JSON", ParseJSONList)})
+}
+
+// ParseJSONList reads a JSON array whose elements are either URL strings or objects; object keys are
+// mapped using the SourceSettings Columns
+func ParseJSONList(content []byte, ss *model.SourceSettings) ([]*ListItem, error) {
+	var elements []interface{}
+	if err := json.Unmarshal(content, &elements); err != nil {
+		return nil, fmt.Errorf("unable to parse JSON list, expected an array: %v", err)
+	}
+
+	columns := ss.Columns
+	var result []*ListItem
+	for index, element := range elements {
+		switch value := element.(type) {
+		case string:
+			result = append(result, &ListItem{Link: strings.TrimSpace(value)})
+		case map[string]interface{}:
+			item := &ListItem{
+				Link:  jsonListText(value, columns.URL),
+				Title: jsonListText(value, columns.Title),
+				Date:  jsonListText(value, columns.Date)}
+			switch tags := value[columns.Tags].(type) {
+			case string:
+				item.Tags = splitTags(tags, columns.TagsDelimiter)
+			case []interface{}:
+				for _, tag := range tags {
+					if text, ok := tag.(string); ok && len(strings.TrimSpace(text)) > 0 {
+						item.Tags = append(item.Tags, strings.TrimSpace(text))
+					}
+				}
+			}
+			result = append(result, item)
+		default:
+			return result, fmt.Errorf("unable to parse JSON list element %d, expected a string or object but found %T", index, element)
+		}
+	}
+	return result, nil
+}
+
+func jsonListText(object map[string]interface{}, key string) string {
+	if text, ok := object[key].(string); ok {
+		return strings.TrimSpace(text)
+	}
+	return ""
+}
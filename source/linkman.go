@@ -1,6 +1,7 @@
 package source
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -23,11 +24,39 @@ func (l simpleLink) FinalURL() (*url.URL, error) {
 	return url.Parse(string(l))
 }
 
+// harvestIssue is a link.Issue raised by the LinksManager itself (satisfies link.Issue interface)
+type harvestIssue struct {
+	code    link.IssueCode
+	message string
+}
+
+func (i harvestIssue) IssueCode() link.IssueCode {
+	return i.code
+}
+
+func (i harvestIssue) Issue() string {
+	return i.message
+}
+
+func (i harvestIssue) IsError() bool {
+	return true
+}
+
+func (i harvestIssue) String() string {
+	return i.message
+}
+
 // LinksManager wraps LinkLifecyleSettings and implements a number of interfaces
 type LinksManager struct {
-	Config       *model.Configuration
-	LinkSettings *model.LinkLifecyleSettings
-	Client       *http.Client
+	Context        context.Context
+	Config         *model.Configuration
+	LinkSettings   *model.LinkLifecyleSettings
+	ClientSettings *model.HTTPClientSettings
+	Client         *http.Client
+	Pool           *HarvestPool
+	Robots         *RobotsCache
+	Attachments    *RepositoryAttachments
+	Activities     ActivityReporter
 }
 
 // HTTPClient defines the HTTP client for the link destination to use
@@ -36,47 +65,104 @@ func (lm LinksManager) HTTPClient() *http.Client {
 	return lm.Client
 }
 
+// domainPolicy returns the LinkDomainPolicy overriding the LinkLifecyleSettings for url, or nil if there is none
+func (lm LinksManager) domainPolicy(url *url.URL) *model.LinkDomainPolicy {
+	if url == nil {
+		return nil
+	}
+	return lm.LinkSettings.DomainPolicy(url.Hostname())
+}
+
+// TraverseLinks returns true if the given url should be traversed
+func (lm LinksManager) TraverseLinks(url *url.URL) bool {
+	if policy := lm.domainPolicy(url); policy != nil && policy.TraverseLinks != nil {
+		return *policy.TraverseLinks
+	}
+	return lm.LinkSettings.TraverseLinks
+}
+
+// ScoreLinks returns the scoring settings for the given url
+func (lm LinksManager) ScoreLinks(url *url.URL) *model.LinkScoresLifecycleSettings {
+	if policy := lm.domainPolicy(url); policy != nil && policy.ScoreLinks != nil {
+		return policy.ScoreLinks
+	}
+	return &lm.LinkSettings.ScoreLinks
+}
+
+// UserAgent returns the User-Agent sent with requests for url, the domain policy's or the HTTPClientSettings one
+func (lm LinksManager) UserAgent(url *url.URL) string {
+	if policy := lm.domainPolicy(url); policy != nil && policy.UserAgent != nil {
+		return *policy.UserAgent
+	}
+	if lm.ClientSettings != nil && len(lm.ClientSettings.UserAgent) > 0 {
+		return lm.ClientSettings.UserAgent
+	}
+	return "github.com/lectio/source.LinksManager"
+}
+
 // PrepareRequest satisfies resource.Policy interface
 func (lm LinksManager) PrepareRequest(client *http.Client, req *http.Request) {
-	req.Header.Set("User-Agent", "github.com/lectio/source.LinksManager")
+	req.Header.Set("User-Agent", lm.UserAgent(req.URL))
+	if lm.Context != nil {
+		// the policy can't return a new request so replace it in place to carry the cancellation
+		*req = *req.WithContext(lm.Context)
+	}
 }
 
 // DetectRedirectsInHTMLContent defines whether we detect redirect rules in HTML <meta> refresh tags
 // This method satisfies resource.Policy interface
-func (lm LinksManager) DetectRedirectsInHTMLContent(*url.URL) bool {
-	return lm.LinkSettings.FollowRedirectsInLinkDestinationHTMLContent
+func (lm LinksManager) DetectRedirectsInHTMLContent(url *url.URL) bool {
+	return lm.FollowRedirectsInHTMLContent(url)
 }
 
 // FollowRedirectsInHTMLContent defines whether we follow redirect rules in HTML <meta> refresh tags
 func (lm LinksManager) FollowRedirectsInHTMLContent(url *url.URL) bool {
+	if policy := lm.domainPolicy(url); policy != nil && policy.FollowRedirectsInLinkDestinationHTMLContent != nil {
+		return *policy.FollowRedirectsInLinkDestinationHTMLContent
+	}
 	return lm.LinkSettings.FollowRedirectsInLinkDestinationHTMLContent
 }
 
 // ParseMetaDataInHTMLContent defines whether we want to parse HTML meta data
 // This method satisfies resource.Policy interface
-func (lm LinksManager) ParseMetaDataInHTMLContent(*url.URL) bool {
+func (lm LinksManager) ParseMetaDataInHTMLContent(url *url.URL) bool {
+	if policy := lm.domainPolicy(url); policy != nil && policy.ParseMetaDataInLinkDestinationHTMLContent != nil {
+		return *policy.ParseMetaDataInLinkDestinationHTMLContent
+	}
 	return lm.LinkSettings.ParseMetaDataInLinkDestinationHTMLContent
 }
 
 // DownloadContent satisfies Policy method
 func (lm LinksManager) DownloadContent(url *url.URL, resp *http.Response, typ resource.Type) (bool, resource.Attachment, []resource.Issue) {
-	if !lm.LinkSettings.DownloadLinkDestinationAttachments {
+	download := lm.LinkSettings.DownloadLinkDestinationAttachments
+	if policy := lm.domainPolicy(url); policy != nil && policy.DownloadLinkDestinationAttachments != nil {
+		download = *policy.DownloadLinkDestinationAttachments
+	}
+	if !download {
 		return false, nil, nil
 	}
-	return resource.DownloadFile(lm, url, resp, typ)
+	downloaded, attachment, issues := resource.DownloadFile(lm, url, resp, typ)
+	lm.Attachments.Downloaded(url, downloaded && attachment != nil && len(issues) == 0)
+	return downloaded, attachment, issues
 }
 
-// CreateFile satisfies FileAttachmentPolicy method
+// CreateFile satisfies FileAttachmentPolicy method, attachments are only saved when there's a repository to save
+// them into
 func (lm LinksManager) CreateFile(url *url.URL, t resource.Type) (*os.File, resource.Issue) {
-	return nil, resource.NewIssue(url.String(), "NOT_IMPLEMENTED_YET", "CreateFile not implemented in graph.LinkLifecyleSettings", true)
+	if lm.Attachments == nil {
+		return nil, resource.NewIssue(url.String(), "DLERR-0200-NOATTACHMENTS", "Attachments can only be downloaded into a repository", true)
+	}
+	return lm.Attachments.CreateFile(url, t)
 }
 
 // AutoAssignExtension satisfies FileAttachmentPolicy method
 func (lm LinksManager) AutoAssignExtension(url *url.URL, t resource.Type) bool {
-	return true
+	// RepositoryAttachments assigns the extension itself so that it can record the file's name
+	return lm.Attachments == nil
 }
 
-// IgnoreLink returns true (and a reason) if the given url should be ignored by the harvester
+// IgnoreLink returns true (and a reason) if the given url should be ignored by the harvester; when there are
+// allow rules only the URLs matching at least one of them are harvested
 func (lm LinksManager) IgnoreLink(url *url.URL) (bool, string) {
 	URLtext := url.String()
 	for _, regEx := range lm.LinkSettings.IgnoreURLsRegExprs {
@@ -84,6 +170,14 @@ func (lm LinksManager) IgnoreLink(url *url.URL) (bool, string) {
 			return true, fmt.Sprintf("Matched Ignore Rule `%s`", regEx.String())
 		}
 	}
+	if len(lm.LinkSettings.AllowURLsRegExprs) > 0 {
+		for _, regEx := range lm.LinkSettings.AllowURLsRegExprs {
+			if regEx.MatchString(URLtext) {
+				return false, ""
+			}
+		}
+		return true, "Did not match any Allow Rule"
+	}
 	return false, ""
 }
 
@@ -106,7 +200,22 @@ func (lm LinksManager) RemoveQueryParamFromLinkURL(url *url.URL, paramName strin
 
 // HarvestLink satisfies the link.Lifecyle interface and creates a new Link from a URL string
 func (lm LinksManager) HarvestLink(urlText string) (link.Link, link.Issue) {
-	if lm.LinkSettings.TraverseLinks {
+	if lm.Context != nil && lm.Context.Err() != nil {
+		return nil, harvestIssue{code: "LINK_HARVEST_CANCELLED", message: fmt.Sprintf("Harvest of %q cancelled: %v", urlText, lm.Context.Err())}
+	}
+	u, _ := url.Parse(urlText)
+	if lm.TraverseLinks(u) {
+		if allowed, reason := lm.Robots.Allowed(lm.Context, lm, u); !allowed {
+			// we may still bookmark the URL, we just don't visit it
+			if lm.Activities != nil {
+				lm.Activities.AddWarning(urlText, "DLWARN-0105-ROBOTSDISALLOWED", reason)
+			}
+			return simpleLink(urlText), nil
+		}
+		// the requests made while traversing are recorded by the client's RedirectTransport
+		if traversal := traversalFromContext(lm.Context); traversal != nil {
+			traversal.setTraversed()
+		}
 		return link.TraverseLink(urlText, lm, lm, lm), nil
 	}
 	sl := simpleLink(urlText)
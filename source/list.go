@@ -0,0 +1,89 @@
+package source
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lectio/graph/model"
+)
+
+// ListItem is a single entry from a local list of links such as a JSON array, CSV file, or plain text file
+type ListItem struct {
+	Link  string
+	Title string
+	Tags  []string
+	Date  string
+}
+
+// ListParserFunc reads the content of a list source into its items
+type ListParserFunc func(content []byte, ss *model.SourceSettings) ([]*ListItem, error)
+
+// splitTags splits delimited tags text, dropping blank tags
+func splitTags(text string, delimiter string) []string {
+	if len(delimiter) == 0 {
+		delimiter = ","
+	}
+	var result []string
+	for _, tag := range strings.Split(text, delimiter) {
+		if tag = strings.TrimSpace(tag); len(tag) > 0 {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// NewBookmarkFromListItem uses the ListItem to create a model.Bookmark
+func NewBookmarkFromListItem(item *ListItem, lm *LinksManager, cs *model.ContentSettings, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+	bookmark := model.Bookmark{
+		Link:       model.BookmarkLink{OriginalURLText: model.URLText(item.Link)},
+		Title:      model.ContentTitleText(item.Title),
+		Properties: model.MakeProperties()}
+
+	if !FinalizeBookmark(&bookmark, lm, cs, errorFn, warnFn) {
+		return nil
+	}
+
+	if len(item.Tags) > 0 {
+		bookmark.Taxonomies = append(bookmark.Taxonomies, cs.Taxonomies.TagsTaxonomy("categories", item.Tags))
+	}
+
+	if len(item.Date) > 0 {
+		bookmark.Properties.Add("list.date", item.Date)
+	}
+
+	return &bookmark
+}
+
+// NewListLinksHandler creates a LinksAPIHandlerFunc which reads a list source's content and harvests its items
+func NewListLinksHandler(kind string, parser ListParserFunc) LinksAPIHandlerFunc {
+	return func(params LinksAPIHandlerParams) (*model.Bookmarks, error) {
+		source, ok := params.Source().(*model.BookmarksAPISource)
+		if !ok {
+			return nil, fmt.Errorf("Source is %+v, %s list source requires a model.BookmarksAPISource", params.Source(), kind)
+		}
+
+		lm := params.LinksManager()
+		cs := params.ContentSettings()
+		harvester := NewBookmarksHarvester(params, source)
+
+		content, readErr := ReadSourceContent(params, string(source.APIEndpoint))
+		if readErr != nil {
+			harvester.AddError(string(source.APIEndpoint), "LISTERR-0001-READ", readErr.Error())
+			return harvester.Bookmarks, nil
+		}
+
+		items, parseErr := parser(content, params.SourceSettings())
+		if parseErr != nil {
+			harvester.AddError(string(source.APIEndpoint), "LISTERR-0002-PARSE", parseErr.Error())
+			return harvester.Bookmarks, nil
+		}
+
+		return harvester.Harvest(len(items),
+			func(index int) string {
+				return fmt.Sprintf("[%s] %s link %d %q", source.APIEndpoint, kind, index, items[index].Link)
+			},
+			func(index int, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+				return NewBookmarkFromListItem(items[index], lm, cs, errorFn, warnFn)
+			}), nil
+	}
+}
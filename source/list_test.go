@@ -0,0 +1,115 @@
+package source
+
+import (
+	"io"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lectio/graph/model"
+)
+
+func TestListLinks(t *testing.T) {
+	root, rootErr := filepath.Abs("testdata")
+	if rootErr != nil {
+		t.Fatalf("unable to find testdata: %v", rootErr)
+	}
+
+	type item struct {
+		link       string
+		title      string
+		categories []string
+		date       string
+	}
+	tagged := []item{{"https://example.com/a", "Page A", []string{"go", "testing"}, "2019-09-02"}, {"https://example.com/b", "Page B", nil, ""}}
+	tests := []struct {
+		file string
+		want []item
+	}{
+		{"links.csv", tagged},
+		{"links.tsv", tagged},
+		{"links.json", []item{tagged[0], {"https://example.com/b", "", nil, ""}}},
+		{"links.txt", []item{{"https://example.com/a", "", nil, ""}, {"https://example.com/b", "", nil, ""}}},
+	}
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			urlText := "file://" + filepath.ToSlash(filepath.Join(root, test.file))
+			_, handler, detectErr := DetectAPIFromURLText(model.URLText(urlText))
+			if detectErr != nil {
+				t.Fatalf("unable to detect %q: %v", urlText, detectErr)
+			}
+			params := newTestParams(t, urlText, func(config *model.Configuration, path model.SettingsPath) {
+				config.SourceSettings(path).LocalFilesRootPath = root
+			})
+			bookmarks, err := handler(params)
+			if err != nil {
+				t.Fatalf("handler returned %v", err)
+			}
+			if len(bookmarks.Activities.Errors) > 0 {
+				t.Fatalf("handler reported errors: %+v", bookmarks.Activities.Errors)
+			}
+			if len(bookmarks.Content) != len(test.want) {
+				t.Fatalf("handler returned %d bookmarks, want %d", len(bookmarks.Content), len(test.want))
+			}
+			for i, want := range test.want {
+				bookmark := bookmarks.Content[i]
+				if string(bookmark.Link.OriginalURLText) != want.link || string(bookmark.Title) != want.title {
+					t.Errorf("bookmark %d = %q %q, want %q %q", i, bookmark.Link.OriginalURLText, bookmark.Title, want.link, want.title)
+				}
+				if got := taxa(bookmark, "categories"); !reflect.DeepEqual(got, want.categories) {
+					t.Errorf("bookmark %d categories = %q, want %q", i, got, want.categories)
+				}
+				date, _ := bookmark.Properties.Get("list.date")
+				if date == nil {
+					date = ""
+				}
+				if date != want.date {
+					t.Errorf("bookmark %d list.date = %q, want %q", i, date, want.date)
+				}
+			}
+		})
+	}
+}
+
+func TestListLinksFromStdin(t *testing.T) {
+	defer func(original io.Reader) { stdin = original }(stdin)
+
+	tests := []struct {
+		name       string
+		urlText    string
+		allow      bool
+		want       []string
+		wantErrors int
+	}{
+		{"stdin URL", "stdin://", true, []string{"https://example.com/a", "https://example.com/b"}, 0},
+		{"dash", "-", true, []string{"https://example.com/a", "https://example.com/b"}, 0},
+		{"not allowed", "stdin://", false, nil, 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stdin = strings.NewReader("# links to harvest\nhttps://example.com/a\n\nhttps://example.com/b\n")
+			_, handler, detectErr := DetectAPIFromURLText(model.URLText(test.urlText))
+			if detectErr != nil {
+				t.Fatalf("unable to detect %q: %v", test.urlText, detectErr)
+			}
+			params := newTestParams(t, test.urlText, func(config *model.Configuration, path model.SettingsPath) {
+				config.SourceSettings(path).AllowStdin = test.allow
+			})
+			bookmarks, err := handler(params)
+			if err != nil {
+				t.Fatalf("handler returned %v", err)
+			}
+			if len(bookmarks.Activities.Errors) != test.wantErrors {
+				t.Errorf("handler reported %d errors, want %d: %+v", len(bookmarks.Activities.Errors), test.wantErrors, bookmarks.Activities.Errors)
+			}
+			var got []string
+			for _, bookmark := range bookmarks.Content {
+				got = append(got, string(bookmark.Link.OriginalURLText))
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got links %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package source
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/araddon/dateparse"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/lectio/graph/model"
+)
+
+// metaTagProperties maps the <meta> property or name attributes to the bookmark properties they're stored in
+var metaTagProperties = map[string]model.PropertyName{
+	"og:title":               "og.title",
+	"og:description":         "og.description",
+	"og:image":               "og.image",
+	"og:site_name":           "og.siteName",
+	"og:type":                "og.type",
+	"og:url":                 "og.url",
+	"article:published_time": "article.publishedTime",
+	"article:modified_time":  "article.modifiedTime",
+	"article:author":         "article.author",
+	"twitter:title":          "twitter.title",
+	"twitter:description":    "twitter.description",
+	"twitter:image":          "twitter.image",
+	"twitter:creator":        "twitter.creator",
+	"twitter:site":           "twitter.site",
+	"author":                 "meta.author",
+	"description":            "meta.description",
+}
+
+// descriptionProperties are the properties, in priority order, which describe the page
+var descriptionProperties = []model.PropertyName{"og.description", "twitter.description", "jsonld.description", "meta.description"}
+
+// urlProperties hold URLs which are resolved against the page's URL
+var urlProperties = map[model.PropertyName]bool{"og.image": true, "twitter.image": true, "jsonld.image": true, "link.canonical": true}
+
+// jsonLDArticleTypes are the schema.org types whose fields describe the page's article
+var jsonLDArticleTypes = map[string]bool{
+	"Article": true, "NewsArticle": true, "BlogPosting": true, "TechArticle": true, "ScholarlyArticle": true,
+	"Report": true, "AnalysisNewsArticle": true, "OpinionNewsArticle": true, "ReportageNewsArticle": true,
+}
+
+// PageMetadata returns the OpenGraph, Twitter Card, schema.org JSON-LD Article and HTML metadata, including the
+// canonical link, of a page as namespaced properties; the first value found for each property wins
+func PageMetadata(doc *html.Node, base *url.URL) *model.Properties {
+	values := make(map[model.PropertyName]string)
+	var names []model.PropertyName
+	set := func(name model.PropertyName, value string) {
+		value = strings.TrimSpace(value)
+		if _, ok := values[name]; ok || len(value) == 0 {
+			return
+		}
+		if urlProperties[name] {
+			value = resolveReference(base, value)
+		}
+		values[name] = value
+		names = append(names, name)
+	}
+
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		if node.Type == html.ElementNode {
+			switch node.DataAtom {
+			case atom.Title:
+				set("html.title", nodeText(node))
+			case atom.Link:
+				for _, rel := range strings.Fields(strings.ToLower(attribute(node, "rel"))) {
+					if rel == "canonical" {
+						set("link.canonical", attribute(node, "href"))
+					}
+				}
+			case atom.Meta:
+				key := attribute(node, "property")
+				if len(key) == 0 {
+					key = attribute(node, "name")
+				}
+				if name, ok := metaTagProperties[strings.ToLower(key)]; ok {
+					set(name, attribute(node, "content"))
+				}
+			case atom.Script:
+				if strings.EqualFold(strings.TrimSpace(attribute(node, "type")), "application/ld+json") && node.FirstChild != nil {
+					jsonLDArticle(node.FirstChild.Data, set)
+				}
+			}
+		}
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(doc)
+
+	result := model.MakeProperties()
+	for _, name := range names {
+		result.Add(name, values[name])
+	}
+	return result
+}
+
+// jsonLDArticle reads the fields of the first schema.org Article in a JSON-LD script
+func jsonLDArticle(script string, set func(name model.PropertyName, value string)) {
+	var data interface{}
+	if err := json.Unmarshal([]byte(script), &data); err != nil {
+		return
+	}
+
+	var article map[string]interface{}
+	var find func(interface{})
+	find = func(value interface{}) {
+		if article != nil {
+			return
+		}
+		switch v := value.(type) {
+		case []interface{}:
+			for _, item := range v {
+				find(item)
+			}
+		case map[string]interface{}:
+			if jsonLDIsArticle(v["@type"]) {
+				article = v
+				return
+			}
+			find(v["@graph"])
+		}
+	}
+	find(data)
+	if article == nil {
+		return
+	}
+
+	set("jsonld.headline", jsonLDText(article["headline"], "name"))
+	set("jsonld.description", jsonLDText(article["description"], ""))
+	set("jsonld.datePublished", jsonLDText(article["datePublished"], ""))
+	set("jsonld.dateModified", jsonLDText(article["dateModified"], ""))
+	set("jsonld.author", jsonLDText(article["author"], "name"))
+	set("jsonld.image", jsonLDText(article["image"], "url"))
+	set("jsonld.publisher", jsonLDText(article["publisher"], "name"))
+}
+
+func jsonLDIsArticle(value interface{}) bool {
+	switch t := value.(type) {
+	case string:
+		return jsonLDArticleTypes[t]
+	case []interface{}:
+		for _, item := range t {
+			if jsonLDIsArticle(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// jsonLDText returns a JSON-LD value as text; for objects it uses the given field and for lists the first item
+func jsonLDText(value interface{}, field string) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []interface{}:
+		if len(v) > 0 {
+			return jsonLDText(v[0], field)
+		}
+	case map[string]interface{}:
+		if len(field) > 0 {
+			return jsonLDText(v[field], "")
+		}
+	}
+	return ""
+}
+
+// firstMetadata returns the first of the named metadata properties which has a value
+func firstMetadata(metadata *model.Properties, names ...model.PropertyName) string {
+	for _, name := range names {
+		if value, ok := metadata.Get(name); ok {
+			if text, isText := value.(string); isText && len(text) > 0 {
+				return text
+			}
+		}
+	}
+	return ""
+}
+
+// applyPageMetadata adds the page's metadata to the bookmark's properties and uses it to fill in what's missing
+// according to the ContentMetadataSettings
+func applyPageMetadata(bookmark *model.Bookmark, metadata *model.Properties, cs *model.ContentSettings) {
+	if bookmark.Properties == nil {
+		bookmark.Properties = model.MakeProperties()
+	}
+	metadata.ForEach(func(name model.PropertyName, value interface{}) {
+		if _, exists := bookmark.Properties.Get(name); !exists {
+			bookmark.Properties.Add(name, value)
+		}
+	})
+
+	settings := cs.Metadata
+	if settings.FillMissingTitle && len(strings.TrimSpace(string(bookmark.Title))) == 0 {
+		if title := firstMetadata(metadata, "og.title", "twitter.title", "jsonld.headline", "html.title"); len(title) > 0 {
+			bookmark.Title = model.ContentTitleText(title)
+			bookmark.Title.Edit(bookmark, &cs.Title)
+		}
+	}
+	if settings.FillMissingSummary && len(strings.TrimSpace(string(bookmark.Summary))) == 0 {
+		if summary := firstMetadata(metadata, descriptionProperties...); len(summary) > 0 {
+			bookmark.Summary = model.ContentSummaryText(summary)
+		}
+	}
+	if settings.FillMissingDate {
+		// the pipelines only use this date when the source didn't provide one
+		if published := firstMetadata(metadata, "article.publishedTime", "jsonld.datePublished"); len(published) > 0 {
+			if date, err := dateparse.ParseAny(published); err == nil {
+				if _, exists := bookmark.Properties.Get("metadata.publishedAt"); !exists {
+					bookmark.Properties.Add("metadata.publishedAt", date.Format(time.RFC3339))
+				}
+			}
+		}
+	}
+	if settings.FillMissingFeaturedImage {
+		// the pipelines only use this image when the source didn't provide one
+		if image := firstMetadata(metadata, "og.image", "twitter.image", "jsonld.image"); len(image) > 0 {
+			if _, exists := bookmark.Properties.Get("metadata.featuredImageURL"); !exists {
+				bookmark.Properties.Add("metadata.featuredImageURL", image)
+			}
+		}
+	}
+}
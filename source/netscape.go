@@ -0,0 +1,219 @@
+package source
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/lectio/graph/model"
+)
+
+// NetscapeBookmarksSourceName is the registered name of the Netscape bookmarks.html export source
+const NetscapeBookmarksSourceName = "NetscapeBookmarks"
+
+func init() {
+	// local exports may have any name but remote ones must look like a bookmarks export
+	pattern, _ := model.MakeRegularExpression(`(?i)^(file\://.+\.html?|https?\://.+/[^/]*bookmark[^/]*\.html?)$`)
+	MustRegister(&Registration{Name: NetscapeBookmarksSourceName, URLPattern: pattern, Handler: NetscapeBookmarksLinks})
+}
+
+// NetscapeBookmark is a single <A> entry in a Netscape bookmarks.html export
+type NetscapeBookmark struct {
+	Link         string
+	Title        string
+	Description  string
+	Folders      []string
+	Tags         []string
+	AddDate      *time.Time
+	LastModified *time.Time
+}
+
+// ParseNetscapeBookmarks reads a Netscape bookmarks.html export, tracking the folder each bookmark appears in
+func ParseNetscapeBookmarks(content []byte) ([]*NetscapeBookmark, error) {
+	var result []*NetscapeBookmark
+	var folders []string
+	var current *NetscapeBookmark
+	var pendingFolder *string
+	// each <DL> pushes true if it opened a folder's list so that its </DL> knows whether to pop the folder
+	var lists []bool
+
+	z := html.NewTokenizer(bytes.NewReader(content))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			if z.Err() == io.EOF {
+				return result, nil
+			}
+			return result, fmt.Errorf("unable to parse Netscape bookmarks: %v", z.Err())
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := z.Token()
+			// a folder's <H3> is followed by its <DL>, or by a <DD> describing it; an empty folder has neither
+			if pendingFolder != nil && token.DataAtom != atom.Dl && token.DataAtom != atom.Dd {
+				pendingFolder = nil
+			}
+			switch token.DataAtom {
+			case atom.Dl:
+				if pendingFolder != nil {
+					folders = append(folders, *pendingFolder)
+					pendingFolder = nil
+					lists = append(lists, true)
+				} else {
+					lists = append(lists, false)
+				}
+			case atom.H3:
+				name := strings.TrimSpace(netscapeText(z, atom.H3))
+				pendingFolder = &name
+			case atom.A:
+				bookmark := &NetscapeBookmark{Folders: append([]string(nil), folders...)}
+				for _, attr := range token.Attr {
+					switch strings.ToLower(attr.Key) {
+					case "href":
+						bookmark.Link = strings.TrimSpace(attr.Val)
+					case "add_date":
+						bookmark.AddDate = netscapeTimestamp(attr.Val)
+					case "last_modified":
+						bookmark.LastModified = netscapeTimestamp(attr.Val)
+					case "tags":
+						for _, tag := range strings.Split(attr.Val, ",") {
+							if tag = strings.TrimSpace(tag); len(tag) > 0 {
+								bookmark.Tags = append(bookmark.Tags, tag)
+							}
+						}
+					}
+				}
+				bookmark.Title = strings.TrimSpace(netscapeText(z, atom.A))
+				result = append(result, bookmark)
+				current = bookmark
+			case atom.Dd:
+				// <DD> has no end tag in exports, its text runs until the next tag
+				if current != nil {
+					if z.Next() == html.TextToken {
+						current.Description = strings.TrimSpace(string(z.Text()))
+					}
+				}
+			case atom.Dt:
+				current = nil
+			}
+
+		case html.EndTagToken:
+			token := z.Token()
+			pendingFolder = nil
+			if token.DataAtom == atom.Dl && len(lists) > 0 {
+				if lists[len(lists)-1] && len(folders) > 0 {
+					folders = folders[:len(folders)-1]
+				}
+				lists = lists[:len(lists)-1]
+				current = nil
+			}
+		}
+	}
+}
+
+// netscapeText collects the text until the closing tag of the element that was just opened
+func netscapeText(z *html.Tokenizer, closing atom.Atom) string {
+	var text strings.Builder
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return text.String()
+		case html.TextToken:
+			text.Write(z.Text())
+		case html.EndTagToken:
+			if z.Token().DataAtom == closing {
+				return text.String()
+			}
+		}
+	}
+}
+
+// netscapeTimestamp converts the Unix epoch seconds used by ADD_DATE and LAST_MODIFIED
+func netscapeTimestamp(value string) *time.Time {
+	seconds, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || seconds <= 0 {
+		return nil
+	}
+	result := time.Unix(seconds, 0).UTC()
+	return &result
+}
+
+// NewBookmarkFromNetscapeBookmark uses the NetscapeBookmark to create a model.Bookmark
+func NewBookmarkFromNetscapeBookmark(item *NetscapeBookmark, lm *LinksManager, cs *model.ContentSettings, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+	lowerLink := strings.ToLower(item.Link)
+	if len(item.Link) > 0 && !strings.HasPrefix(lowerLink, "http://") && !strings.HasPrefix(lowerLink, "https://") {
+		warnFn("NBWARN-0101-NOTHTTP", fmt.Sprintf("Link %q is not an HTTP URL (bookmarklet or browser-specific), skipping", item.Link))
+		return nil
+	}
+
+	bookmark := model.Bookmark{
+		Link:       model.BookmarkLink{OriginalURLText: model.URLText(item.Link)},
+		Title:      model.ContentTitleText(item.Title),
+		Summary:    model.ContentSummaryText(item.Description),
+		Properties: model.MakeProperties()}
+
+	if !FinalizeBookmark(&bookmark, lm, cs, errorFn, warnFn) {
+		return nil
+	}
+
+	if len(item.Folders) > 0 {
+		folders := model.HiearchicalTaxonomy{Name: "folders"}
+		path := make([]model.TaxonName, len(item.Folders))
+		for i, folder := range item.Folders {
+			path[i] = model.TaxonName(folder)
+		}
+		folders.AddPath(path...)
+		bookmark.Taxonomies = append(bookmark.Taxonomies, folders)
+	}
+
+	if len(item.Tags) > 0 {
+		bookmark.Taxonomies = append(bookmark.Taxonomies, cs.Taxonomies.TagsTaxonomy("categories", item.Tags))
+	}
+
+	if item.AddDate != nil {
+		bookmark.Properties.Add("netscape.addDate", item.AddDate.Format(time.RFC3339))
+	}
+	if item.LastModified != nil {
+		bookmark.Properties.Add("netscape.lastModified", item.LastModified.Format(time.RFC3339))
+	}
+
+	return &bookmark
+}
+
+// NetscapeBookmarksLinks returns a collection of harvested links from a Netscape bookmarks.html export
+func NetscapeBookmarksLinks(params LinksAPIHandlerParams) (*model.Bookmarks, error) {
+	source, ok := params.Source().(*model.BookmarksAPISource)
+	if !ok {
+		return nil, fmt.Errorf("Source is %+v, source.NetscapeBookmarksLinks requires a model.BookmarksAPISource", params.Source())
+	}
+
+	lm := params.LinksManager()
+	cs := params.ContentSettings()
+	harvester := NewBookmarksHarvester(params, source)
+
+	content, readErr := ReadSourceContent(params, string(source.APIEndpoint))
+	if readErr != nil {
+		harvester.AddError(string(source.APIEndpoint), "NBERR-0001-READ", readErr.Error())
+		return harvester.Bookmarks, nil
+	}
+
+	items, parseErr := ParseNetscapeBookmarks(content)
+	if parseErr != nil {
+		harvester.AddError(string(source.APIEndpoint), "NBERR-0002-PARSE", parseErr.Error())
+		return harvester.Bookmarks, nil
+	}
+
+	return harvester.Harvest(len(items),
+		func(index int) string {
+			return fmt.Sprintf("[%s] Netscape bookmark %d %q", source.APIEndpoint, index, items[index].Link)
+		},
+		func(index int, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+			return NewBookmarkFromNetscapeBookmark(items[index], lm, cs, errorFn, warnFn)
+		}), nil
+}
@@ -0,0 +1,126 @@
+package source
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/lectio/graph/model"
+)
+
+func TestParseNetscapeBookmarks(t *testing.T) {
+	added := time.Unix(1567418400, 0).UTC()
+	modified := time.Unix(1567504800, 0).UTC()
+	tests := []struct {
+		name    string
+		content string
+		want    []*NetscapeBookmark
+	}{
+		{"nested folders", `<DL><p>
+<DT><H3>Health IT</H3>
+<DL><p>
+	<DT><A HREF="https://example.com/fhir" ADD_DATE="1567418400" LAST_MODIFIED="1567504800" TAGS="fhir, standards,">FHIR</A>
+	<DD>An introduction
+	<DT><H3>Interoperability</H3>
+	<DL><p>
+		<DT><A HREF="https://example.com/interop">Interop</A>
+	</DL><p>
+</DL><p>
+<DT><A HREF="https://example.com/top">Top</A>
+</DL>`, []*NetscapeBookmark{
+			{Link: "https://example.com/fhir", Title: "FHIR", Description: "An introduction", Folders: []string{"Health IT"},
+				Tags: []string{"fhir", "standards"}, AddDate: &added, LastModified: &modified},
+			{Link: "https://example.com/interop", Title: "Interop", Folders: []string{"Health IT", "Interoperability"}},
+			{Link: "https://example.com/top", Title: "Top"},
+		}},
+		{"folder without a list", `<DL><p>
+<DT><H3>Empty</H3>
+<DT><A HREF="https://example.com/a">A</A>
+<DT><H3>Also Empty</H3>
+</DL><p>
+<DL><p>
+<DT><A HREF="https://example.com/b">B</A>
+</DL>`, []*NetscapeBookmark{
+			{Link: "https://example.com/a", Title: "A"},
+			{Link: "https://example.com/b", Title: "B"},
+		}},
+		{"folder with a description", `<DL><p>
+<DT><H3>Described</H3>
+<DD>A folder with a description
+<DL><p>
+	<DT><A HREF="https://example.com/a">A</A>
+</DL>
+</DL>`, []*NetscapeBookmark{
+			{Link: "https://example.com/a", Title: "A", Folders: []string{"Described"}},
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseNetscapeBookmarks([]byte(test.content))
+			if err != nil {
+				t.Fatalf("ParseNetscapeBookmarks returned %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ParseNetscapeBookmarks returned")
+				for _, bookmark := range got {
+					t.Errorf("  %+v", *bookmark)
+				}
+			}
+		})
+	}
+}
+
+func TestNetscapeBookmarksLinks(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+
+	params := newTestParams(t, server.URL+"/bookmarks.html", nil)
+	bookmarks, err := NetscapeBookmarksLinks(params)
+	if err != nil {
+		t.Fatalf("NetscapeBookmarksLinks returned %v", err)
+	}
+	if len(bookmarks.Activities.Errors) > 0 {
+		t.Fatalf("NetscapeBookmarksLinks reported errors: %+v", bookmarks.Activities.Errors)
+	}
+
+	tests := []struct {
+		link       string
+		title      string
+		folders    []string
+		categories []string
+		addDate    string
+	}{
+		{"https://example.com/fhir", "FHIR Overview", []string{"Health IT"}, []string{"fhir", "standards"}, "2019-09-02T10:00:00Z"},
+		{"https://example.com/hl7", "HL7", []string{"Health IT"}, nil, ""},
+		{"https://example.com/interop", "Interop Guide", []string{"Health IT/Interoperability"}, nil, ""},
+		{"https://example.com/top", "Top Level", nil, nil, ""},
+	}
+	if len(bookmarks.Content) != len(tests) {
+		t.Fatalf("NetscapeBookmarksLinks returned %d bookmarks, want %d", len(bookmarks.Content), len(tests))
+	}
+	if len(bookmarks.Activities.Warnings) != 1 {
+		t.Errorf("NetscapeBookmarksLinks reported %d warnings, want 1 for the bookmarklet", len(bookmarks.Activities.Warnings))
+	}
+	for i, test := range tests {
+		bookmark := bookmarks.Content[i]
+		if got := string(bookmark.Link.OriginalURLText); got != test.link {
+			t.Errorf("bookmark %d link = %q, want %q", i, got, test.link)
+		}
+		if got := string(bookmark.Title); got != test.title {
+			t.Errorf("bookmark %d title = %q, want %q", i, got, test.title)
+		}
+		if got := taxa(bookmark, "folders"); !reflect.DeepEqual(got, test.folders) {
+			t.Errorf("bookmark %d folders = %q, want %q", i, got, test.folders)
+		}
+		if got := taxa(bookmark, "categories"); !reflect.DeepEqual(got, test.categories) {
+			t.Errorf("bookmark %d categories = %q, want %q", i, got, test.categories)
+		}
+		addDate, _ := bookmark.Properties.Get(model.PropertyName("netscape.addDate"))
+		if addDate == nil {
+			addDate = ""
+		}
+		if addDate != test.addDate {
+			t.Errorf("bookmark %d netscape.addDate = %q, want %q", i, addDate, test.addDate)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package source
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/lectio/graph/model"
+)
+
+// OPMLSourceName is the registered name of the OPML outlines source
+const OPMLSourceName = "OPML"
+
+func init() {
+	pattern, _ := model.MakeRegularExpression(`(?i)^(file|https?)\://.+\.opml$`)
+	MustRegister(&Registration{Name: OPMLSourceName, URLPattern: pattern, Handler: OPMLLinks})
+}
+
+// OPMLOutline is a single, possibly nested, <outline> element in an OPML document
+type OPMLOutline struct {
+	Text        string        `xml:"text,attr"`
+	Title       string        `xml:"title,attr"`
+	Type        string        `xml:"type,attr"`
+	XMLURL      string        `xml:"xmlUrl,attr"`
+	HTMLURL     string        `xml:"htmlUrl,attr"`
+	Description string        `xml:"description,attr"`
+	Outlines    []OPMLOutline `xml:"outline"`
+}
+
+// OPMLDocument is the root of an OPML document
+type OPMLDocument struct {
+	Title    string        `xml:"head>title"`
+	Outlines []OPMLOutline `xml:"body>outline"`
+}
+
+// Name returns the outline's text or, if there is no text, its title
+func (o OPMLOutline) Name() string {
+	if text := strings.TrimSpace(o.Text); len(text) > 0 {
+		return text
+	}
+	return strings.TrimSpace(o.Title)
+}
+
+// ParseOPML reads an OPML document
+func ParseOPML(content []byte) (*OPMLDocument, error) {
+	var doc OPMLDocument
+	if err := xml.NewDecoder(bytes.NewReader(content)).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("unable to parse OPML: %v", err)
+	}
+	return &doc, nil
+}
+
+// Walk calls visit for every outline in the document, depth first, along with the names of its ancestors
+func (d OPMLDocument) Walk(visit func(outline *OPMLOutline, ancestors []string)) {
+	var walk func(outlines []OPMLOutline, ancestors []string)
+	walk = func(outlines []OPMLOutline, ancestors []string) {
+		for i := range outlines {
+			outline := &outlines[i]
+			visit(outline, ancestors)
+			if len(outline.Outlines) > 0 {
+				walk(outline.Outlines, append(append([]string(nil), ancestors...), outline.Name()))
+			}
+		}
+	}
+	walk(d.Outlines, nil)
+}
+
+// opmlItem is a single bookmark candidate along with the outline hierarchy it was found in
+type opmlItem struct {
+	entry    *FeedEntry
+	outlines []string
+}
+
+// newBookmarkFromOPMLItem uses the outline or feed entry to create a model.Bookmark, keeping its outline hierarchy
+func newBookmarkFromOPMLItem(item *opmlItem, lm *LinksManager, cs *model.ContentSettings, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+	bookmark := NewBookmarkFromFeedEntry(item.entry, lm, cs, errorFn, warnFn)
+	if bookmark == nil {
+		return nil
+	}
+
+	if len(item.outlines) > 0 {
+		outlines := model.HiearchicalTaxonomy{Name: "outlines"}
+		path := make([]model.TaxonName, len(item.outlines))
+		for i, outline := range item.outlines {
+			path[i] = model.TaxonName(outline)
+		}
+		outlines.AddPath(path...)
+		bookmark.Taxonomies = append(bookmark.Taxonomies, outlines)
+	}
+
+	return bookmark
+}
+
+// OPMLLinks returns a collection of harvested links from an OPML document; depending on the SourceSettings
+// OutlinePolicy each outline's htmlUrl becomes a bookmark or each outline's xmlUrl feed is harvested
+func OPMLLinks(params LinksAPIHandlerParams) (*model.Bookmarks, error) {
+	source, ok := params.Source().(*model.BookmarksAPISource)
+	if !ok {
+		return nil, fmt.Errorf("Source is %+v, source.OPMLLinks requires a model.BookmarksAPISource", params.Source())
+	}
+
+	lm := params.LinksManager()
+	cs := params.ContentSettings()
+	ss := params.SourceSettings()
+	harvester := NewBookmarksHarvester(params, source)
+
+	content, readErr := ReadSourceContent(params, string(source.APIEndpoint))
+	if readErr != nil {
+		harvester.AddError(string(source.APIEndpoint), "OPMLERR-0001-READ", readErr.Error())
+		return harvester.Bookmarks, nil
+	}
+
+	doc, parseErr := ParseOPML(content)
+	if parseErr != nil {
+		harvester.AddError(string(source.APIEndpoint), "OPMLERR-0002-PARSE", parseErr.Error())
+		return harvester.Bookmarks, nil
+	}
+
+	// outlines of a remote OPML document are written by whoever publishes it so they may not read local files
+	localOPML := isLocalURL(string(source.APIEndpoint))
+	var items []*opmlItem
+	doc.Walk(func(outline *OPMLOutline, ancestors []string) {
+		switch ss.OutlinePolicy {
+		case model.SourceOutlinePolicyHarvestFeeds:
+			if len(outline.XMLURL) == 0 || params.Context().Err() != nil {
+				return
+			}
+			feedContext := fmt.Sprintf("[%s] OPML outline %q feed %q", source.APIEndpoint, outline.Name(), outline.XMLURL)
+			if isLocalURL(outline.XMLURL) && !localOPML {
+				harvester.AddError(feedContext, "OPMLERR-0103-FEEDLOCAL", "local feeds may only be harvested from local OPML documents")
+				return
+			}
+			feedContent, feedReadErr := ReadSourceContent(params, strings.TrimSpace(outline.XMLURL))
+			if feedReadErr != nil {
+				harvester.AddError(feedContext, "OPMLERR-0101-FEEDREAD", feedReadErr.Error())
+				return
+			}
+			feed, feedParseErr := ParseFeed(feedContent)
+			if feedParseErr != nil {
+				harvester.AddError(feedContext, "OPMLERR-0102-FEEDPARSE", feedParseErr.Error())
+				return
+			}
+			outlines := append(append([]string(nil), ancestors...), outline.Name())
+			for _, entry := range feed.Entries {
+				items = append(items, &opmlItem{entry: entry, outlines: outlines})
+			}
+		default:
+			if len(outline.HTMLURL) == 0 {
+				return
+			}
+			entry := &FeedEntry{
+				Link:    strings.TrimSpace(outline.HTMLURL),
+				Title:   outline.Name(),
+				Summary: strings.TrimSpace(outline.Description)}
+			items = append(items, &opmlItem{entry: entry, outlines: ancestors})
+		}
+	})
+
+	return harvester.Harvest(len(items),
+		func(index int) string {
+			return fmt.Sprintf("[%s] OPML link %d %q", source.APIEndpoint, index, items[index].entry.Link)
+		},
+		func(index int, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+			return newBookmarkFromOPMLItem(items[index], lm, cs, errorFn, warnFn)
+		}), nil
+}
@@ -0,0 +1,116 @@
+package source
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lectio/graph/model"
+)
+
+// newOPMLTestServer serves testdata like newTestServer but points the OPML outlines' feeds at itself
+func newOPMLTestServer(t *testing.T) *httptest.Server {
+	opml, readErr := ioutil.ReadFile("testdata/subscriptions.opml")
+	if readErr != nil {
+		t.Fatalf("unable to read OPML fixture: %v", readErr)
+	}
+	files := http.FileServer(http.Dir("testdata"))
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/subscriptions.opml" {
+			w.Write([]byte(strings.Replace(string(opml), "{{server}}", server.URL, -1)))
+			return
+		}
+		files.ServeHTTP(w, r)
+	}))
+	return server
+}
+
+func TestOPMLLinks(t *testing.T) {
+	server := newOPMLTestServer(t)
+	defer server.Close()
+
+	type bookmark struct {
+		link     string
+		title    string
+		outlines []string
+	}
+	tests := []struct {
+		policy     model.SourceOutlinePolicy
+		want       []bookmark
+		wantErrors int
+	}{
+		{model.SourceOutlinePolicyBookmarkWebsites, []bookmark{
+			{"https://example.com/posts", "Example RSS", []string{"Technology"}},
+			{"https://example.com/entries", "Example Atom", []string{"Technology"}},
+			{"https://example.com/missing", "Missing", nil},
+		}, 0},
+		{model.SourceOutlinePolicyHarvestFeeds, []bookmark{
+			{"https://example.com/posts/first", "First Post", []string{"Technology/Example RSS"}},
+			{"https://example.com/posts/second", "Second Post", []string{"Technology/Example RSS"}},
+			{"https://example.com/entries/first", "First Entry", []string{"Technology/Example Atom"}},
+			{"https://example.com/entries/second", "Second Entry", []string{"Technology/Example Atom"}},
+		}, 1},
+	}
+	for _, test := range tests {
+		t.Run(string(test.policy), func(t *testing.T) {
+			params := newTestParams(t, server.URL+"/subscriptions.opml", func(config *model.Configuration, path model.SettingsPath) {
+				config.SourceSettings(path).OutlinePolicy = test.policy
+			})
+			bookmarks, err := OPMLLinks(params)
+			if err != nil {
+				t.Fatalf("OPMLLinks returned %v", err)
+			}
+			if len(bookmarks.Activities.Errors) != test.wantErrors {
+				t.Errorf("OPMLLinks reported %d errors, want %d: %+v", len(bookmarks.Activities.Errors), test.wantErrors, bookmarks.Activities.Errors)
+			}
+			if len(bookmarks.Content) != len(test.want) {
+				t.Fatalf("OPMLLinks returned %d bookmarks, want %d", len(bookmarks.Content), len(test.want))
+			}
+			for i, want := range test.want {
+				got := bookmarks.Content[i]
+				if string(got.Link.OriginalURLText) != want.link || string(got.Title) != want.title {
+					t.Errorf("bookmark %d = %q %q, want %q %q", i, got.Link.OriginalURLText, got.Title, want.link, want.title)
+				}
+				if outlines := taxa(got, "outlines"); !reflect.DeepEqual(outlines, want.outlines) {
+					t.Errorf("bookmark %d outlines = %q, want %q", i, outlines, want.outlines)
+				}
+			}
+		})
+	}
+}
+
+func TestOPMLLinksLocalFeeds(t *testing.T) {
+	server := httptest.NewServer(http.FileServer(http.Dir("testdata")))
+	defer server.Close()
+
+	tests := []struct {
+		name          string
+		urlText       string
+		wantBookmarks int
+		wantErrors    int
+	}{
+		{"local OPML", "file://testdata/local.opml", 2, 0},
+		{"remote OPML", server.URL + "/local.opml", 0, 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := newTestParams(t, test.urlText, func(config *model.Configuration, path model.SettingsPath) {
+				config.SourceSettings(path).OutlinePolicy = model.SourceOutlinePolicyHarvestFeeds
+			})
+			bookmarks, err := OPMLLinks(params)
+			if err != nil {
+				t.Fatalf("OPMLLinks returned %v", err)
+			}
+			if len(bookmarks.Content) != test.wantBookmarks {
+				t.Errorf("OPMLLinks returned %d bookmarks, want %d", len(bookmarks.Content), test.wantBookmarks)
+			}
+			if len(bookmarks.Activities.Errors) != test.wantErrors {
+				t.Errorf("OPMLLinks reported %d errors, want %d: %+v", len(bookmarks.Activities.Errors), test.wantErrors, bookmarks.Activities.Errors)
+			}
+		})
+	}
+}
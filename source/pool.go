@@ -0,0 +1,110 @@
+package source
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/lectio/graph/model"
+)
+
+// HarvestPool limits how many links are harvested concurrently, in total and for each host
+type HarvestPool struct {
+	global     chan struct{}
+	maxPerHost int
+	mutex      sync.Mutex
+	hosts      map[string]chan struct{}
+}
+
+var sharedHarvestPools = struct {
+	sync.Mutex
+	pools map[*model.LinkLifecyleSettings]*HarvestPool
+}{pools: make(map[*model.LinkLifecyleSettings]*HarvestPool)}
+
+// NewHarvestPool creates a pool allowing max concurrent harvests and maxPerHost concurrent harvests for any single
+// host; zero or negative limits mean unlimited
+func NewHarvestPool(max, maxPerHost int) *HarvestPool {
+	result := new(HarvestPool)
+	if max > 0 {
+		result.global = make(chan struct{}, max)
+	}
+	result.maxPerHost = maxPerHost
+	result.hosts = make(map[string]chan struct{})
+	return result
+}
+
+// SharedHarvestPool returns the pool shared by all sources using the same LinkLifecyleSettings
+func SharedHarvestPool(lls *model.LinkLifecyleSettings) *HarvestPool {
+	sharedHarvestPools.Lock()
+	defer sharedHarvestPools.Unlock()
+	pool, ok := sharedHarvestPools.pools[lls]
+	if !ok {
+		pool = NewHarvestPool(lls.MaxConcurrentHarvests, lls.MaxConcurrentHarvestsPerHost)
+		sharedHarvestPools.pools[lls] = pool
+	}
+	return pool
+}
+
+// Workers returns how many workers should harvest count items, never more than the global limit
+func (p *HarvestPool) Workers(count int) int {
+	if p != nil && p.global != nil && cap(p.global) < count {
+		return cap(p.global)
+	}
+	return count
+}
+
+// Acquire blocks until a harvest slot for the host of urlText and then a global harvest slot are available and
+// returns the function that releases both; the host slot is taken first so that harvests waiting for a busy host
+// don't hold global slots that harvests of other hosts could use. If ctx is done first no slot is held and its
+// error is returned.
+func (p *HarvestPool) Acquire(ctx context.Context, urlText string) (func(), error) {
+	if p == nil {
+		return func() {}, nil
+	}
+	releaseHost, hostErr := p.acquireHost(ctx, urlText)
+	if hostErr != nil {
+		return nil, hostErr
+	}
+	if p.global == nil {
+		return releaseHost, nil
+	}
+	select {
+	case p.global <- struct{}{}:
+	case <-ctx.Done():
+		releaseHost()
+		return nil, ctx.Err()
+	}
+	return func() {
+		<-p.global
+		releaseHost()
+	}, nil
+}
+
+// acquireHost blocks until a harvest slot for the host of urlText is available, or ctx is done, and returns the
+// function that releases it
+func (p *HarvestPool) acquireHost(ctx context.Context, urlText string) (func(), error) {
+	if p.maxPerHost <= 0 {
+		return func() {}, nil
+	}
+
+	host := urlText
+	if u, err := url.Parse(urlText); err == nil && len(u.Host) > 0 {
+		host = strings.ToLower(u.Host)
+	}
+
+	p.mutex.Lock()
+	slots, ok := p.hosts[host]
+	if !ok {
+		slots = make(chan struct{}, p.maxPerHost)
+		p.hosts[host] = slots
+	}
+	p.mutex.Unlock()
+
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
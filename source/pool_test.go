@@ -0,0 +1,142 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestHarvestPoolLimits(t *testing.T) {
+	tests := []struct {
+		name          string
+		max           int
+		maxPerHost    int
+		hosts         int
+		perHost       int
+		wantMaxGlobal int
+		wantMaxHost   int
+	}{
+		{"global limit", 3, 0, 4, 4, 3, 3},
+		{"host limit", 0, 2, 3, 4, 6, 2},
+		{"both limits", 4, 1, 3, 4, 3, 1},
+		{"unlimited", 0, 0, 2, 3, 6, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pool := NewHarvestPool(test.max, test.maxPerHost)
+			var mutex sync.Mutex
+			global, maxGlobal := 0, 0
+			hosts, maxHost := make(map[int]int), 0
+			var wg sync.WaitGroup
+			for host := 0; host < test.hosts; host++ {
+				for i := 0; i < test.perHost; i++ {
+					wg.Add(1)
+					go func(host int) {
+						defer wg.Done()
+						release, _ := pool.Acquire(context.Background(), fmt.Sprintf("https://host%d.example.com/page", host))
+						mutex.Lock()
+						global++
+						hosts[host]++
+						if global > maxGlobal {
+							maxGlobal = global
+						}
+						if hosts[host] > maxHost {
+							maxHost = hosts[host]
+						}
+						mutex.Unlock()
+						time.Sleep(20 * time.Millisecond)
+						mutex.Lock()
+						global--
+						hosts[host]--
+						mutex.Unlock()
+						release()
+					}(host)
+				}
+			}
+			wg.Wait()
+			if maxGlobal != test.wantMaxGlobal || maxHost != test.wantMaxHost {
+				t.Errorf("at most %d harvests and %d for a host, want %d and %d", maxGlobal, maxHost, test.wantMaxGlobal, test.wantMaxHost)
+			}
+		})
+	}
+}
+
+func TestHarvestPoolBusyHostDoesNotStarveOthers(t *testing.T) {
+	pool := NewHarvestPool(2, 1)
+	releaseBusy, _ := pool.Acquire(context.Background(), "https://busy.example.com/first")
+
+	// more harvests of the busy host than there are global slots wait for it
+	var waiting sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		waiting.Add(1)
+		go func(i int) {
+			defer waiting.Done()
+			release, _ := pool.Acquire(context.Background(), fmt.Sprintf("https://busy.example.com/%d", i))
+			release()
+		}(i)
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	acquired := make(chan func())
+	go func() {
+		release, _ := pool.Acquire(context.Background(), "https://other.example.com/")
+		acquired <- release
+	}()
+	select {
+	case release := <-acquired:
+		release()
+	case <-time.After(time.Second):
+		t.Fatalf("harvest of another host starved while the busy host's harvests waited")
+	}
+
+	releaseBusy()
+	waiting.Wait()
+}
+
+func TestHarvestPoolAcquireCancelled(t *testing.T) {
+	tests := []struct {
+		name       string
+		max        int
+		maxPerHost int
+		busyURL    string
+	}{
+		{"waiting for the host", 0, 1, "https://example.com/busy"},
+		{"waiting for the global slot", 1, 1, "https://other.example.com/busy"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pool := NewHarvestPool(test.max, test.maxPerHost)
+			releaseBusy, _ := pool.Acquire(context.Background(), test.busyURL)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			acquired := make(chan error)
+			go func() {
+				release, err := pool.Acquire(ctx, "https://example.com/waiting")
+				if err == nil {
+					release()
+				}
+				acquired <- err
+			}()
+			time.Sleep(20 * time.Millisecond)
+			cancel()
+			select {
+			case err := <-acquired:
+				if err != context.Canceled {
+					t.Errorf("got %v, want %v", err, context.Canceled)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("cancelled harvest kept waiting for a slot")
+			}
+
+			// the cancelled harvest holds no slot so the host can be harvested once the busy harvest is done
+			releaseBusy()
+			release, err := pool.Acquire(context.Background(), "https://example.com/next")
+			if err != nil {
+				t.Fatalf("got %v", err)
+			}
+			release()
+		})
+	}
+}
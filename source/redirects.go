@@ -0,0 +1,180 @@
+package source
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/lectio/graph/model"
+)
+
+// linkTraversalKey is the context key of the linkTraversal a link's requests are recorded into
+type linkTraversalKey struct{}
+
+// redirectHop is a single request made while traversing a link
+type redirectHop struct {
+	url         string
+	statusCode  int
+	duration    time.Duration
+	err         error
+	metaRefresh bool
+}
+
+// linkTraversal records the requests made while traversing a single link, in the order they were made, and the
+// last HTML page read so that the destination doesn't have to be retrieved again to harvest it; each traversal has
+// its own, carried by the context of its requests, so concurrent traversals of the same URL don't mix
+type linkTraversal struct {
+	mutex     sync.Mutex
+	traversed bool
+	hops      []*redirectHop
+	pageURL   *url.URL
+	page      []byte
+}
+
+// withLinkTraversal returns a context which records the traversal of a link into traversal
+func withLinkTraversal(ctx context.Context, traversal *linkTraversal) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, linkTraversalKey{}, traversal)
+}
+
+// traversalFromContext returns the linkTraversal carried by ctx, or nil if there is none
+func traversalFromContext(ctx context.Context) *linkTraversal {
+	if ctx == nil {
+		return nil
+	}
+	traversal, _ := ctx.Value(linkTraversalKey{}).(*linkTraversal)
+	return traversal
+}
+
+func (lt *linkTraversal) setTraversed() {
+	lt.mutex.Lock()
+	lt.traversed = true
+	lt.mutex.Unlock()
+}
+
+func (lt *linkTraversal) isTraversed() bool {
+	lt.mutex.Lock()
+	defer lt.mutex.Unlock()
+	return lt.traversed
+}
+
+func (lt *linkTraversal) addHop(hop *redirectHop) {
+	lt.mutex.Lock()
+	lt.hops = append(lt.hops, hop)
+	lt.mutex.Unlock()
+}
+
+// setPage records an HTML page read completely by hop, the last one read is the destination
+func (lt *linkTraversal) setPage(hop *redirectHop, u *url.URL, page []byte) {
+	refresh := hasMetaRefresh(page)
+	lt.mutex.Lock()
+	lt.pageURL = u
+	lt.page = page
+	hop.metaRefresh = refresh
+	lt.mutex.Unlock()
+}
+
+// redirects converts the hops into model.LinkRedirect instances; a hop with a 3xx status was redirected by HTTP
+// and one whose page has an HTML <meta> refresh, followed by another hop, was redirected by the refresh
+func (lt *linkTraversal) redirects() []model.LinkRedirect {
+	lt.mutex.Lock()
+	defer lt.mutex.Unlock()
+	if len(lt.hops) == 0 {
+		return nil
+	}
+	result := make([]model.LinkRedirect, len(lt.hops))
+	for i, hop := range lt.hops {
+		result[i] = model.LinkRedirect{URL: model.URLText(hop.url), StatusCode: hop.statusCode, Duration: model.TimeoutDuration(hop.duration)}
+		if hop.err != nil {
+			message := hop.err.Error()
+			result[i].Error = &message
+			continue
+		}
+		var redirect model.LinkRedirectType
+		if hop.statusCode >= 300 && hop.statusCode < 400 {
+			redirect = model.LinkRedirectTypeHTTP
+		} else if hop.metaRefresh && i < len(lt.hops)-1 {
+			redirect = model.LinkRedirectTypeMetaRefresh
+		} else {
+			continue
+		}
+		result[i].Redirect = &redirect
+	}
+	return result
+}
+
+// hasMetaRefresh returns true if the head of the HTML page has a <meta http-equiv="refresh"> leading to a URL
+func hasMetaRefresh(page []byte) bool {
+	z := html.NewTokenizer(bytes.NewReader(page))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return false
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := z.Token()
+			if token.DataAtom == atom.Body {
+				return false
+			}
+			if token.DataAtom != atom.Meta {
+				continue
+			}
+			var refresh bool
+			var content string
+			for _, attr := range token.Attr {
+				switch strings.ToLower(attr.Key) {
+				case "http-equiv":
+					refresh = strings.EqualFold(strings.TrimSpace(attr.Val), "refresh")
+				case "content":
+					content = attr.Val
+				}
+			}
+			if refresh && strings.Contains(strings.ToLower(content), "url=") {
+				return true
+			}
+		}
+	}
+}
+
+// RedirectTransport is an http.RoundTripper which records each request, including those http.Client makes to
+// follow redirects, into the link traversal carried by the request's context; the HTML pages read while
+// traversing a link are captured too so that they can be harvested without retrieving them again
+type RedirectTransport struct {
+	Base http.RoundTripper
+}
+
+// NewRedirectChainClient returns a copy of client which records redirect chains
+func NewRedirectChainClient(client *http.Client) *http.Client {
+	result := *client
+	result.Transport = &RedirectTransport{Base: client.Transport}
+	return &result
+}
+
+// RoundTrip satisfies http.RoundTripper
+func (t *RedirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	traversal := traversalFromContext(req.Context())
+	if traversal == nil {
+		return base.RoundTrip(req)
+	}
+	started := time.Now()
+	resp, err := base.RoundTrip(req)
+	hop := &redirectHop{url: req.URL.String(), duration: time.Since(started), err: err}
+	if resp != nil {
+		hop.statusCode = resp.StatusCode
+		resp = capturePage(traversal, hop, req, resp)
+	}
+	traversal.addHop(hop)
+	return resp, err
+}
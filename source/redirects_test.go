@@ -0,0 +1,151 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/lectio/graph/model"
+)
+
+// newRedirectsTestServer serves pages redirected by HTTP and by HTML <meta> refresh
+func newRedirectsTestServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/moved":
+			http.Redirect(w, r, "/refresh", http.StatusMovedPermanently)
+		case "/moved-plain":
+			http.Redirect(w, r, "/plain", http.StatusFound)
+		case "/refresh":
+			w.Header().Set("Content-Type", "text/html")
+			fmt.Fprint(w, `<html><head><META HTTP-EQUIV="Refresh" CONTENT="0; URL=/final"></head><body></body></html>`)
+		case "/refresh-in-body":
+			w.Header().Set("Content-Type", "text/html")
+			fmt.Fprint(w, `<html><head></head><body><p>Not a redirect</p><meta http-equiv="refresh" content="0; url=/final"></body></html>`)
+		case "/reload":
+			w.Header().Set("Content-Type", "text/html")
+			fmt.Fprint(w, `<html><head><meta http-equiv="refresh" content="30"></head><body></body></html>`)
+		case "/plain", "/final":
+			w.Header().Set("Content-Type", "text/html")
+			fmt.Fprint(w, `<html><head><title>Page</title></head><body></body></html>`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+// traverse requests each path in turn through the client with the traversal's context and reads the pages, the
+// way link.TraverseLink follows HTTP redirects and HTML <meta> refreshes
+func traverse(t *testing.T, client *http.Client, traversal *linkTraversal, server string, paths ...string) {
+	t.Helper()
+	for _, path := range paths {
+		req, _ := http.NewRequest(http.MethodGet, server+path, nil)
+		req = req.WithContext(withLinkTraversal(context.Background(), traversal))
+		resp, err := client.Do(req)
+		if err != nil {
+			continue
+		}
+		ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+	}
+}
+
+func TestLinkTraversalRedirects(t *testing.T) {
+	http301, refresh := model.LinkRedirectTypeHTTP, model.LinkRedirectTypeMetaRefresh
+	type hop struct {
+		path     string
+		status   int
+		redirect *model.LinkRedirectType
+	}
+	tests := []struct {
+		name  string
+		paths []string
+		want  []hop
+	}{
+		{"not redirected", []string{"/plain"}, []hop{{"/plain", 200, nil}}},
+		{"HTTP redirect", []string{"/moved-plain"}, []hop{{"/moved-plain", 302, &http301}, {"/plain", 200, nil}}},
+		{"HTTP and meta refresh", []string{"/moved", "/final"}, []hop{{"/moved", 301, &http301}, {"/refresh", 200, &refresh}, {"/final", 200, nil}}},
+		{"meta refresh is the last hop", []string{"/refresh"}, []hop{{"/refresh", 200, nil}}},
+		{"page without meta refresh followed by another", []string{"/plain", "/final"}, []hop{{"/plain", 200, nil}, {"/final", 200, nil}}},
+		{"meta refresh in body", []string{"/refresh-in-body", "/final"}, []hop{{"/refresh-in-body", 200, nil}, {"/final", 200, nil}}},
+		{"meta refresh without URL", []string{"/reload", "/final"}, []hop{{"/reload", 200, nil}, {"/final", 200, nil}}},
+		{"not found", []string{"/missing"}, []hop{{"/missing", 404, nil}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newRedirectsTestServer()
+			defer server.Close()
+			client := NewRedirectChainClient(server.Client())
+			traversal := new(linkTraversal)
+			traverse(t, client, traversal, server.URL, test.paths...)
+
+			redirects := traversal.redirects()
+			if len(redirects) != len(test.want) {
+				t.Fatalf("got %+v, want %d hops", redirects, len(test.want))
+			}
+			for i, want := range test.want {
+				got := redirects[i]
+				if got.URL != model.URLText(server.URL+want.path) || got.StatusCode != want.status || got.Error != nil {
+					t.Errorf("hop %d is %s %d (%v), want %s %d", i, got.URL, got.StatusCode, got.Error, want.path, want.status)
+				}
+				if (got.Redirect == nil) != (want.redirect == nil) || (got.Redirect != nil && *got.Redirect != *want.redirect) {
+					t.Errorf("hop %d redirect is %v, want %v", i, got.Redirect, want.redirect)
+				}
+			}
+		})
+	}
+}
+
+func TestLinkTraversalRecordsErrors(t *testing.T) {
+	server := newRedirectsTestServer()
+	serverURL := server.URL
+	server.Close()
+
+	traversal := new(linkTraversal)
+	traverse(t, NewRedirectChainClient(&http.Client{}), traversal, serverURL, "/plain")
+	redirects := traversal.redirects()
+	if len(redirects) != 1 || redirects[0].Error == nil || redirects[0].Redirect != nil {
+		t.Errorf("got %+v, want a single failed hop", redirects)
+	}
+}
+
+func TestLinkTraversalsOfTheSameURLDontMix(t *testing.T) {
+	server := newRedirectsTestServer()
+	defer server.Close()
+	client := NewRedirectChainClient(server.Client())
+
+	const concurrent = 8
+	traversals := make([]*linkTraversal, concurrent)
+	var wg sync.WaitGroup
+	for i := range traversals {
+		traversals[i] = new(linkTraversal)
+		wg.Add(1)
+		go func(traversal *linkTraversal) {
+			defer wg.Done()
+			traverse(t, client, traversal, server.URL, "/moved", "/final")
+		}(traversals[i])
+	}
+	wg.Wait()
+	for i, traversal := range traversals {
+		if redirects := traversal.redirects(); len(redirects) != 3 {
+			t.Errorf("traversal %d recorded %d hops, want 3", i, len(redirects))
+		}
+	}
+}
+
+func TestRedirectTransportWithoutTraversal(t *testing.T) {
+	server := newRedirectsTestServer()
+	defer server.Close()
+	resp, err := NewRedirectChainClient(server.Client()).Get(server.URL + "/moved")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,144 @@
+package source
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/lectio/graph/model"
+)
+
+// URLMatcherFunc returns true if the given URL text should be handled by a registered source
+type URLMatcherFunc func(model.URLText) bool
+
+// ContentSourceFactory creates the model.ContentSource for a URL that was matched by a registered source
+type ContentSourceFactory func(name model.NameText, urlText model.URLText) model.ContentSource
+
+// FallbackPriority is the Registration priority of sources whose URL pattern is a heuristic that more specific
+// sources should win over
+const FallbackPriority = -100
+
+// Registration describes a ContentSource that can be detected from a URL; registrations with a higher Priority
+// are matched first
+type Registration struct {
+	Name       model.NameText
+	URLPattern *model.RegularExpression
+	Matcher    URLMatcherFunc
+	Factory    ContentSourceFactory
+	Handler    LinksAPIHandlerFunc
+	Priority   int
+}
+
+// Registry keeps the list of registered sources in match order
+type Registry struct {
+	mutex         sync.RWMutex
+	registrations []*Registration
+}
+
+// DefaultRegistry is the registry used by DetectFromURLText and DetectAPIFromURLText
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry creates an empty source registry
+func NewRegistry() *Registry {
+	return new(Registry)
+}
+
+// Register adds a source to the DefaultRegistry
+func Register(reg *Registration) error {
+	return DefaultRegistry.Register(reg)
+}
+
+// MustRegister adds a source to the DefaultRegistry and panics if the registration is invalid
+func MustRegister(reg *Registration) {
+	if err := Register(reg); err != nil {
+		panic(err)
+	}
+}
+
+// NewBookmarksAPISource is the default ContentSourceFactory for sources that return bookmarks
+func NewBookmarksAPISource(name model.NameText, urlText model.URLText) model.ContentSource {
+	return &model.BookmarksAPISource{Name: name, APIEndpoint: urlText}
+}
+
+// Register adds a source to the registry; sources with a higher priority are matched first and sources with the
+// same priority are matched in the order they were registered
+func (r *Registry) Register(reg *Registration) error {
+	if reg == nil {
+		return fmt.Errorf("nil registration passed into source.Registry.Register")
+	}
+	if len(reg.Name) == 0 {
+		return fmt.Errorf("registration name is required in source.Registry.Register")
+	}
+	if reg.Matcher == nil && reg.URLPattern == nil {
+		return fmt.Errorf("registration %q requires a Matcher or URLPattern in source.Registry.Register", reg.Name)
+	}
+	if reg.Handler == nil {
+		return fmt.Errorf("registration %q requires a Handler in source.Registry.Register", reg.Name)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, existing := range r.registrations {
+		if existing.Name == reg.Name {
+			return fmt.Errorf("source %q is already registered in source.Registry.Register", reg.Name)
+		}
+	}
+	index := len(r.registrations)
+	for index > 0 && r.registrations[index-1].Priority < reg.Priority {
+		index--
+	}
+	r.registrations = append(r.registrations, nil)
+	copy(r.registrations[index+1:], r.registrations[index:])
+	r.registrations[index] = reg
+	return nil
+}
+
+// Registrations returns a copy of the registered sources in match order
+func (r *Registry) Registrations() []*Registration {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	result := make([]*Registration, len(r.registrations))
+	copy(result, r.registrations)
+	return result
+}
+
+// RegisteredSources returns the GraphQL representation of the registered sources
+func (r *Registry) RegisteredSources() []model.RegisteredSource {
+	registrations := r.Registrations()
+	result := make([]model.RegisteredSource, len(registrations))
+	for i, reg := range registrations {
+		result[i] = model.RegisteredSource{Name: reg.Name, URLPattern: reg.URLPattern}
+	}
+	return result
+}
+
+// Match returns the first registration that matches the given URL text or nil if none match
+func (r *Registry) Match(urlText model.URLText) *Registration {
+	for _, reg := range r.Registrations() {
+		if reg.matches(urlText) {
+			return reg
+		}
+	}
+	return nil
+}
+
+// Detect finds the ContentSource and handler for the given URL text
+func (r *Registry) Detect(urlText model.URLText) (model.ContentSource, LinksAPIHandlerFunc, error) {
+	reg := r.Match(urlText)
+	if reg == nil {
+		result := model.BookmarksAPISource{Name: UnknownSourceName, APIEndpoint: urlText}
+		return &result, nil, fmt.Errorf("unable to detect %q as a valid ContentSource in source.DetectFromURLText", urlText)
+	}
+
+	factory := reg.Factory
+	if factory == nil {
+		factory = NewBookmarksAPISource
+	}
+	return factory(reg.Name, urlText), reg.Handler, nil
+}
+
+func (reg *Registration) matches(urlText model.URLText) bool {
+	if reg.Matcher != nil {
+		return reg.Matcher(urlText)
+	}
+	return reg.URLPattern.MatchString(string(urlText))
+}
@@ -0,0 +1,89 @@
+package source
+
+import (
+	"testing"
+
+	"github.com/lectio/graph/model"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		urlText model.URLText
+		want    model.NameText
+	}{
+		{"https://shah.dropmark.com/616548.json", DropmarkSourceName},
+		{"https://example.com/feed", FeedSourceName},
+		{"https://example.com/feed/", FeedSourceName},
+		{"https://example.com/blog/rss?format=full", FeedSourceName},
+		{"https://example.com/posts.atom", FeedSourceName},
+		{"https://example.com/blog/feed.xml", FeedSourceName},
+		{"https://example.com/index.xml", FeedSourceName},
+		{"https://example.com/subscriptions.opml", OPMLSourceName},
+		{"https://example.com/my-bookmarks.html", NetscapeBookmarksSourceName},
+		{"file:///tmp/bookmarks.csv", CSVListSourceName},
+		{"file:///tmp/bookmarks.tsv", TSVListSourceName},
+		{"file:///tmp/bookmarks.json", JSONListSourceName},
+		{"file:///tmp/links.txt", URLListSourceName},
+		{"https://example.com/sitemap.xml", UnknownSourceName},
+		{"https://example.com/data/export.xml", UnknownSourceName},
+		{"https://example.com/posts/first", UnknownSourceName},
+		{"stdin://", URLListSourceName},
+		{"-", URLListSourceName},
+	}
+	for _, test := range tests {
+		t.Run(string(test.urlText), func(t *testing.T) {
+			contentSource, _, _ := DefaultRegistry.Detect(test.urlText)
+			apiSource, ok := contentSource.(*model.BookmarksAPISource)
+			if !ok {
+				t.Fatalf("Detect returned %T, want *model.BookmarksAPISource", contentSource)
+			}
+			if apiSource.Name != test.want {
+				t.Errorf("Detect(%q) = %q, want %q", test.urlText, apiSource.Name, test.want)
+			}
+		})
+	}
+}
+
+func TestRegistryPriority(t *testing.T) {
+	pattern := func(expr string) *model.RegularExpression {
+		result, err := model.MakeRegularExpression(expr)
+		if err != nil {
+			t.Fatalf("unable to compile %q: %v", expr, err)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name          string
+		registrations []*Registration
+		urlText       model.URLText
+		want          model.NameText
+	}{
+		{"same priority matches in registration order",
+			[]*Registration{{Name: "First", URLPattern: pattern(`\.xml$`), Handler: FeedLinks}, {Name: "Second", URLPattern: pattern(`\.xml$`), Handler: FeedLinks}},
+			"https://example.com/a.xml", "First"},
+		{"higher priority registered later wins",
+			[]*Registration{{Name: "Generic", URLPattern: pattern(`\.xml$`), Handler: FeedLinks, Priority: FallbackPriority}, {Name: "Specific", URLPattern: pattern(`/sitemap\.xml$`), Handler: FeedLinks}},
+			"https://example.com/sitemap.xml", "Specific"},
+		{"fallback still matches what nothing else does",
+			[]*Registration{{Name: "Generic", URLPattern: pattern(`\.xml$`), Handler: FeedLinks, Priority: FallbackPriority}, {Name: "Specific", URLPattern: pattern(`/sitemap\.xml$`), Handler: FeedLinks}},
+			"https://example.com/a.xml", "Generic"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			registry := NewRegistry()
+			for _, reg := range test.registrations {
+				if err := registry.Register(reg); err != nil {
+					t.Fatalf("unable to register %q: %v", reg.Name, err)
+				}
+			}
+			reg := registry.Match(test.urlText)
+			if reg == nil {
+				t.Fatalf("Match(%q) = nil, want %q", test.urlText, test.want)
+			}
+			if reg.Name != test.want {
+				t.Errorf("Match(%q) = %q, want %q", test.urlText, reg.Name, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package source
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lectio/markdown"
+	"github.com/spf13/afero"
+
+	"github.com/lectio/graph/model"
+)
+
+// RepositorySourceName is the registered name of the source which reads previously generated markdown content
+const RepositorySourceName = "Repository"
+
+// repositoryURLPrefix starts the URL of a repository source, it's followed by the model.RepositoryName
+const repositoryURLPrefix = "repository://"
+
+// TaxonomyPathsFrontMatterSuffix is appended to the name of a hierarchical taxonomy for the front matter key which
+// lists its paths, the taxonomy's own key lists its leaves
+const TaxonomyPathsFrontMatterSuffix = "Paths"
+
+// reservedFrontMatterKeys are written by the BookmarksToMarkdown pipeline and are not bookmark properties
+var reservedFrontMatterKeys = map[string]bool{
+	"archetype": true, "source": true, "date": true, "link": true, "originalLink": true, "linkBrand": true,
+	"slug": true, "title": true, "description": true, "socialScore": true, "socialScoreSimulated": true,
+	"featuredImage": true, "featuredImageCacheErr": true, "draft": true, "attachment": true, "attachmentMediaType": true,
+	"redirectChain": true, "categorizations": true,
+}
+
+func init() {
+	pattern, _ := model.MakeRegularExpression(`(?i)^repository\://.+$`)
+	MustRegister(&Registration{Name: RepositorySourceName, URLPattern: pattern, Handler: RepositoryLinks})
+}
+
+// NewBookmarkFromMarkdown uses the YAML front matter and body of a markdown file to create a model.Bookmark
+func NewBookmarkFromMarkdown(content []byte, lm *LinksManager, cs *model.ContentSettings, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+	frontMatter := make(map[string]interface{})
+	body, haveFrontMatter, fmErr := markdown.ParseYAMLFrontMatter(content, frontMatter)
+	if fmErr != nil {
+		errorFn("REPOERR-0101-FRONTMATTER", fmt.Sprintf("Unable to parse front matter: %v", fmErr))
+		return nil
+	}
+	if !haveFrontMatter {
+		warnFn("REPOWARN-0101-NOFRONTMATTER", "No front matter found, skipping")
+		return nil
+	}
+
+	text := func(key string) string {
+		if value, ok := frontMatter[key]; ok && value != nil {
+			return strings.TrimSpace(fmt.Sprintf("%v", value))
+		}
+		return ""
+	}
+
+	// link is the URL the original link resolved to, it's only used for markdown written without originalLink
+	linkText := text("originalLink")
+	if len(linkText) == 0 {
+		linkText = text("link")
+	}
+	bookmark := model.Bookmark{
+		Link:       model.BookmarkLink{OriginalURLText: model.URLText(linkText)},
+		Title:      model.ContentTitleText(text("title")),
+		Summary:    model.ContentSummaryText(text("description")),
+		Body:       model.ContentBodyText(body),
+		Properties: model.MakeProperties()}
+
+	if !FinalizeBookmark(&bookmark, lm, cs, errorFn, warnFn) {
+		return nil
+	}
+
+	switch date := frontMatter["date"].(type) {
+	case time.Time:
+		bookmark.Properties.Add("markdown.date", date.Format(time.RFC3339))
+	case string:
+		bookmark.Properties.Add("markdown.date", date)
+	}
+
+	// keep the attachment downloaded when the markdown was generated unless the link was downloaded again
+	if _, downloaded := bookmark.Properties.Get("link.attachment"); !downloaded {
+		if attachment := text("attachment"); len(attachment) > 0 {
+			bookmark.Properties.Add("link.attachment", path.Base(attachment))
+			bookmark.Properties.Add("link.attachmentMediaType", text("attachmentMediaType"))
+		}
+	}
+
+	// iterate in a stable order so that taxonomies and properties are always added the same way
+	keys := make([]string, 0, len(frontMatter))
+	for key := range frontMatter {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if reservedFrontMatterKeys[key] {
+			continue
+		}
+		if paths, isPaths := frontMatterTaxonomyPaths(frontMatter, key); isPaths {
+			name := model.TaxonomyName(strings.TrimSuffix(key, TaxonomyPathsFrontMatterSuffix))
+			bookmark.Taxonomies = append(bookmark.Taxonomies, pathsTaxonomy(name, paths, cs.Taxonomies))
+			continue
+		}
+		if _, isLeaves := frontMatterTaxonomyPaths(frontMatter, key+TaxonomyPathsFrontMatterSuffix); isLeaves {
+			// the leaves are already part of the paths
+			continue
+		}
+		switch value := frontMatter[key].(type) {
+		case []interface{}:
+			if taxonomy := frontMatterTaxonomy(model.TaxonomyName(key), value, cs.Taxonomies); taxonomy != nil {
+				bookmark.Taxonomies = append(bookmark.Taxonomies, taxonomy)
+			} else {
+				warnFn("REPOWARN-0102-UNKNOWNLIST", fmt.Sprintf("Front matter %q is not a taxonomy, skipping", key))
+			}
+		case string, bool, int, time.Time:
+			bookmark.Properties.Add(model.PropertyName(key), value)
+		case float64:
+			bookmark.Properties.Add(model.PropertyName(key), fmt.Sprintf("%v", value))
+		default:
+			warnFn("REPOWARN-0103-UNKNOWNTYPE", fmt.Sprintf("Front matter %q has unsupported type %T, skipping", key, value))
+		}
+	}
+
+	return &bookmark
+}
+
+// frontMatterTaxonomy converts a list of taxa to a FlatTaxonomy and a list of taxon nodes to a HiearchicalTaxonomy
+func frontMatterTaxonomy(name model.TaxonomyName, values []interface{}, cts model.ContentTaxonomySettings) model.Taxonomy {
+	flat := model.FlatTaxonomy{Name: name}
+	hierarchy := model.HiearchicalTaxonomy{Name: name}
+	for _, value := range values {
+		switch taxon := value.(type) {
+		case string:
+			flat.Add(cts.Normalize(name, model.TaxonName(taxon)))
+		case map[interface{}]interface{}:
+			node, ok := frontMatterTaxonNode(taxon, func(taxon model.TaxonName) model.TaxonName {
+				return cts.Normalize(name, taxon)
+			})
+			if !ok {
+				return nil
+			}
+			hierarchy.Taxa = append(hierarchy.Taxa, node)
+		default:
+			return nil
+		}
+	}
+	if len(hierarchy.Taxa) > 0 {
+		if len(flat.Taxa) > 0 {
+			return nil
+		}
+		return hierarchy
+	}
+	return flat
+}
+
+// frontMatterTaxonomyPaths returns the paths if key lists the paths of the hierarchical taxonomy whose leaves are
+// listed by the key without the suffix
+func frontMatterTaxonomyPaths(frontMatter map[string]interface{}, key string) ([]string, bool) {
+	if !strings.HasSuffix(key, TaxonomyPathsFrontMatterSuffix) {
+		return nil, false
+	}
+	if _, haveLeaves := frontMatter[strings.TrimSuffix(key, TaxonomyPathsFrontMatterSuffix)].([]interface{}); !haveLeaves {
+		return nil, false
+	}
+	values, ok := frontMatter[key].([]interface{})
+	if !ok {
+		return nil, false
+	}
+	paths := make([]string, 0, len(values))
+	for _, value := range values {
+		taxonPath, ok := value.(string)
+		if !ok {
+			return nil, false
+		}
+		paths = append(paths, taxonPath)
+	}
+	return paths, true
+}
+
+// pathsTaxonomy creates a HiearchicalTaxonomy from delimited paths such as "health/it/interop", normalizing each
+// taxon in the path like the tags of other sources
+func pathsTaxonomy(name model.TaxonomyName, paths []string, cts model.ContentTaxonomySettings) model.Taxonomy {
+	hierarchy := model.HiearchicalTaxonomy{Name: name}
+	for _, taxonPath := range paths {
+		hierarchy.AddPath(cts.TaxonPath(name, taxonPath)...)
+	}
+	return hierarchy
+}
+
+// frontMatterTaxonNode reads a model.TaxonNode that was written to YAML as {taxon: name, taxa: [...]}, normalizing
+// each taxon
+func frontMatterTaxonNode(values map[interface{}]interface{}, normalize func(model.TaxonName) model.TaxonName) (model.TaxonNode, bool) {
+	var result model.TaxonNode
+	if taxon, ok := values["taxon"].(string); ok {
+		name := normalize(model.TaxonName(taxon))
+		result.Taxon = &name
+	}
+	children, _ := values["taxa"].([]interface{})
+	for _, child := range children {
+		childValues, ok := child.(map[interface{}]interface{})
+		if !ok {
+			return result, false
+		}
+		node, ok := frontMatterTaxonNode(childValues, normalize)
+		if !ok {
+			return result, false
+		}
+		result.Taxa = append(result.Taxa, node)
+	}
+	return result, result.Taxon != nil
+}
+
+// RepositoryLinks returns a collection of bookmarks read back from the markdown files in a repository's
+// MarkdownGeneratorSettings ContentPath; the source URL is repository://<name>
+func RepositoryLinks(params LinksAPIHandlerParams) (*model.Bookmarks, error) {
+	source, ok := params.Source().(*model.BookmarksAPISource)
+	if !ok {
+		return nil, fmt.Errorf("Source is %+v, source.RepositoryLinks requires a model.BookmarksAPISource", params.Source())
+	}
+
+	lm := params.LinksManager()
+	cs := params.ContentSettings()
+	config := lm.Config
+	settingsPath := params.SettingsPath()
+	harvester := NewBookmarksHarvester(params, source)
+
+	name := string(source.APIEndpoint)[len(repositoryURLPrefix):]
+	repoMan, repoErr := config.Repositories(settingsPath).OpenRepositoryName(model.RepositoryName(strings.Trim(name, "/")))
+	if repoErr != nil {
+		harvester.AddError(string(source.APIEndpoint), "REPOERR-0001-OPEN", repoErr.Error())
+		return harvester.Bookmarks, nil
+	}
+	defer repoMan.Close()
+
+	contentPath := config.MarkdownGeneratorSettings(settingsPath).ContentPath
+	contentFS := afero.NewBasePathFs(repoMan.FileSystem(), contentPath)
+
+	var fileNames []string
+	walkErr := afero.Walk(contentFS, "/", func(fileName string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.EqualFold(filepath.Ext(fileName), ".md") {
+			fileNames = append(fileNames, fileName)
+		}
+		return nil
+	})
+	if walkErr != nil {
+		harvester.AddError(string(source.APIEndpoint), "REPOERR-0002-WALK", fmt.Sprintf("Unable to read content path %q: %v", contentPath, walkErr))
+		return harvester.Bookmarks, nil
+	}
+
+	return harvester.Harvest(len(fileNames),
+		func(index int) string {
+			return fmt.Sprintf("[%s] markdown file %d %q", source.APIEndpoint, index, fileNames[index])
+		},
+		func(index int, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+			content, readErr := afero.ReadFile(contentFS, fileNames[index])
+			if readErr != nil {
+				errorFn("REPOERR-0102-READ", readErr.Error())
+				return nil
+			}
+			return NewBookmarkFromMarkdown(content, lm, cs, errorFn, warnFn)
+		}), nil
+}
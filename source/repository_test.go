@@ -0,0 +1,51 @@
+package source
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/lectio/graph/model"
+)
+
+func TestNewBookmarkFromMarkdown(t *testing.T) {
+	tests := []struct {
+		name         string
+		frontMatter  string
+		wantLink     string
+		wantTaxonomy []string
+	}{
+		{"original link", "link: https://example.com/final\noriginalLink: https://example.com/original\n",
+			"https://example.com/original", nil},
+		{"link without original link", "link: https://example.com/final\n", "https://example.com/final", nil},
+		{"flat taxa normalized", "link: https://example.com/\ntopics: [Interop, News]\n",
+			"https://example.com/", []string{"Interoperability", "news"}},
+		{"taxon paths normalized", "link: https://example.com/\ntopics: [interop]\ntopicsPaths: [Health IT/interop, health it/Security]\n",
+			"https://example.com/", []string{"health it/Interoperability", "health it/security"}},
+		{"taxon nodes normalized", "link: https://example.com/\ntopics:\n- taxon: Health IT\n  taxa:\n  - taxon: interop\n",
+			"https://example.com/", []string{"health it/Interoperability"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := newTestParams(t, "repository://TEST", func(config *model.Configuration, path model.SettingsPath) {
+				config.ContentSettings(path).Taxonomies.TagPathDelimiter = "/"
+				config.ContentSettings(path).Taxonomies.Normalizations = []model.TaxonomyNormalization{
+					{Taxonomy: "topics", FoldCase: true, Synonyms: []model.TaxonSynonyms{{Preferred: "Interoperability", Synonyms: []model.TaxonName{"interop"}}}},
+				}
+			})
+			content := []byte("---\n" + test.frontMatter + "---\nBody\n")
+			var errors []string
+			bookmark := NewBookmarkFromMarkdown(content, params.LinksManager(), params.ContentSettings(),
+				func(code, message string) { errors = append(errors, code+" "+message) },
+				func(code, message string) {})
+			if bookmark == nil || len(errors) > 0 {
+				t.Fatalf("got bookmark %+v with errors %q", bookmark, errors)
+			}
+			if string(bookmark.Link.OriginalURLText) != test.wantLink {
+				t.Errorf("got original link %q, want %q", bookmark.Link.OriginalURLText, test.wantLink)
+			}
+			if got := taxa(*bookmark, "topics"); !reflect.DeepEqual(got, test.wantTaxonomy) {
+				t.Errorf("got topics %q, want %q", got, test.wantTaxonomy)
+			}
+		})
+	}
+}
@@ -0,0 +1,319 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/lectio/graph/model"
+)
+
+// ActivityReporter records the errors and warnings raised while harvesting links
+type ActivityReporter interface {
+	AddError(context, code, message string)
+	AddWarning(context, code, message string)
+}
+
+// ErrCircuitOpen is returned instead of sending a request to a host whose circuit breaker has tripped
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// CircuitBreakers tracks consecutive failures for each host and stops requests to hosts which keep failing
+type CircuitBreakers struct {
+	failures int
+	cooldown time.Duration
+	mutex    sync.Mutex
+	hosts    map[string]*hostBreaker
+}
+
+type hostBreaker struct {
+	consecutiveFailures int
+	openUntil           time.Time
+	trial               bool
+}
+
+var sharedCircuitBreakers = struct {
+	sync.Mutex
+	breakers map[*model.LinkLifecyleSettings]*CircuitBreakers
+}{breakers: make(map[*model.LinkLifecyleSettings]*CircuitBreakers)}
+
+// NewCircuitBreakers creates breakers which trip after failures consecutive failures for a host and stay open for
+// cooldown; zero or negative failures means the breakers never trip
+func NewCircuitBreakers(failures int, cooldown time.Duration) *CircuitBreakers {
+	result := new(CircuitBreakers)
+	result.failures = failures
+	result.cooldown = cooldown
+	result.hosts = make(map[string]*hostBreaker)
+	return result
+}
+
+// SharedCircuitBreakers returns the breakers shared by all sources using the same LinkLifecyleSettings
+func SharedCircuitBreakers(lls *model.LinkLifecyleSettings) *CircuitBreakers {
+	sharedCircuitBreakers.Lock()
+	defer sharedCircuitBreakers.Unlock()
+	breakers, ok := sharedCircuitBreakers.breakers[lls]
+	if !ok {
+		breakers = NewCircuitBreakers(lls.Retry.CircuitBreakerFailures, time.Duration(lls.Retry.CircuitBreakerCooldown))
+		sharedCircuitBreakers.breakers[lls] = breakers
+	}
+	return breakers
+}
+
+// Allow returns false if the host's breaker is open; once the cooldown has passed a single trial request is let
+// through and its result decides whether the breaker closes or opens again
+func (cb *CircuitBreakers) Allow(host string) bool {
+	if cb == nil || cb.failures <= 0 {
+		return true
+	}
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	breaker, ok := cb.hosts[host]
+	if !ok || breaker.openUntil.IsZero() {
+		return true
+	}
+	now := time.Now()
+	if now.Before(breaker.openUntil) {
+		return false
+	}
+	// half-open: the other requests are refused until the trial succeeds or fails, or until another cooldown has
+	// passed in case the trial was cancelled
+	breaker.trial = true
+	breaker.openUntil = now.Add(cb.cooldown)
+	return true
+}
+
+// Success closes the host's breaker
+func (cb *CircuitBreakers) Success(host string) {
+	if cb == nil || cb.failures <= 0 {
+		return
+	}
+	cb.mutex.Lock()
+	delete(cb.hosts, host)
+	cb.mutex.Unlock()
+}
+
+// Failure counts a failed request for the host and returns true if it tripped the breaker
+func (cb *CircuitBreakers) Failure(host string) bool {
+	if cb == nil || cb.failures <= 0 {
+		return false
+	}
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	breaker, ok := cb.hosts[host]
+	if !ok {
+		breaker = new(hostBreaker)
+		cb.hosts[host] = breaker
+	}
+	breaker.consecutiveFailures++
+	if breaker.trial {
+		breaker.trial = false
+		breaker.openUntil = time.Now().Add(cb.cooldown)
+		return true
+	}
+	if breaker.consecutiveFailures >= cb.failures && breaker.openUntil.IsZero() {
+		breaker.openUntil = time.Now().Add(cb.cooldown)
+		return true
+	}
+	return false
+}
+
+// RetryTransport is an http.RoundTripper which retries transient failures with exponential backoff and uses
+// CircuitBreakers to stop sending requests to hosts which keep failing
+type RetryTransport struct {
+	Base     http.RoundTripper
+	Settings *model.LinkRetrySettings
+	Breakers *CircuitBreakers
+	Reporter func() ActivityReporter
+	Timeout  func(url *url.URL) time.Duration
+}
+
+// cancelOnCloseBody cancels a request's timeout once its response body is closed
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// NewRetryClient returns a copy of client whose transport retries according to the LinkLifecyleSettings; the
+// client's timeout is applied to each attempt instead, unless a LinkDomainPolicy overrides it for the host
+func NewRetryClient(client *http.Client, lls *model.LinkLifecyleSettings, reporter func() ActivityReporter) *http.Client {
+	result := *client
+	result.Timeout = 0
+	result.Transport = &RetryTransport{Base: client.Transport, Settings: &lls.Retry, Breakers: SharedCircuitBreakers(lls), Reporter: reporter,
+		Timeout: func(url *url.URL) time.Duration {
+			if policy := lls.DomainPolicy(url.Hostname()); policy != nil && policy.Timeout != nil {
+				return time.Duration(*policy.Timeout)
+			}
+			return client.Timeout
+		}}
+	return &result
+}
+
+// RoundTrip satisfies the http.RoundTripper interface
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	host := strings.ToLower(req.URL.Host)
+	issueContext := req.URL.String()
+
+	// requests with a body can only be retried if the body can be recreated
+	maxAttempts := t.Settings.MaxAttempts
+	if maxAttempts < 1 || (req.Body != nil && req.GetBody == nil) {
+		maxAttempts = 1
+	}
+
+	backoff := time.Duration(t.Settings.InitialBackoff)
+	for attempt := 1; ; attempt++ {
+		if !t.Breakers.Allow(host) {
+			return nil, fmt.Errorf("%v for host %q, not requesting %q", ErrCircuitOpen, host, issueContext)
+		}
+
+		resp, err := t.roundTrip(base, req)
+		if req.Context().Err() != nil {
+			// a cancelled request says nothing about the host
+			return resp, err
+		}
+		reason, retryable := t.retryable(resp, err)
+		if !retryable {
+			// errors which aren't transient don't say whether the host is healthy
+			if err == nil {
+				t.Breakers.Success(host)
+			}
+			return resp, err
+		}
+		if t.Breakers.Failure(host) {
+			t.warn(issueContext, "DLWARN-0103-CIRCUITOPEN", fmt.Sprintf("Circuit breaker for host %q tripped after %d consecutive failures (%s), pausing requests for %v", host, t.Settings.CircuitBreakerFailures, reason, time.Duration(t.Settings.CircuitBreakerCooldown)))
+			return resp, err
+		}
+		if attempt >= maxAttempts {
+			return resp, err
+		}
+
+		wait := backoff
+		if after := retryAfter(resp); after > wait {
+			wait = after
+		}
+		if maxBackoff := time.Duration(t.Settings.MaxBackoff); maxBackoff > 0 && wait > maxBackoff {
+			wait = maxBackoff
+		}
+		t.warn(issueContext, "DLWARN-0102-RETRY", fmt.Sprintf("Attempt %d of %d failed (%s), retrying in %v", attempt, maxAttempts, reason, wait))
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+			req.Body = body
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// roundTrip sends a single attempt, limited by the Timeout for the request's URL
+func (t *RetryTransport) roundTrip(base http.RoundTripper, req *http.Request) (*http.Response, error) {
+	var timeout time.Duration
+	if t.Timeout != nil {
+		timeout = t.Timeout(req.URL)
+	}
+	if timeout <= 0 {
+		return base.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	resp, err := base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return resp, err
+	}
+	resp.Body = cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, err
+}
+
+// retryable returns true (and a reason) if the response or error is transient and the request should be retried
+func (t *RetryTransport) retryable(resp *http.Response, err error) (string, bool) {
+	if err != nil {
+		return err.Error(), transientError(err)
+	}
+	for _, code := range t.Settings.RetryStatusCodes {
+		if resp.StatusCode == code {
+			return resp.Status, true
+		}
+	}
+	return "", false
+}
+
+// transientError returns true for timeouts and refused or reset connections; other errors such as unknown hosts,
+// invalid certificates, blocked addresses and cancellations fail the same way when they're retried
+func transientError(err error) bool {
+	for err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return true
+		}
+		switch wrapped := err.(type) {
+		case *url.Error:
+			err = wrapped.Err
+		case *net.OpError:
+			err = wrapped.Err
+		case *os.SyscallError:
+			err = wrapped.Err
+		case syscall.Errno:
+			return wrapped == syscall.ECONNREFUSED || wrapped == syscall.ECONNRESET
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+func (t *RetryTransport) warn(context, code, message string) {
+	if t.Reporter == nil {
+		return
+	}
+	if reporter := t.Reporter(); reporter != nil {
+		reporter.AddWarning(context, code, message)
+	}
+}
+
+// retryAfter returns the delay requested by a Retry-After header in seconds or as an HTTP date
+func retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+	value := resp.Header.Get("Retry-After")
+	if len(value) == 0 {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
@@ -0,0 +1,150 @@
+package source
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/lectio/graph/model"
+)
+
+func TestRetryable(t *testing.T) {
+	wrap := func(err error) error {
+		return &url.Error{Op: "Get", URL: "https://example.com/", Err: &net.OpError{Op: "dial", Net: "tcp", Err: err}}
+	}
+	status := func(code int) *http.Response {
+		return &http.Response{StatusCode: code, Status: http.StatusText(code)}
+	}
+	tests := []struct {
+		name string
+		resp *http.Response
+		err  error
+		want bool
+	}{
+		{"connection refused", nil, wrap(&os.SyscallError{Syscall: "connect", Err: syscall.ECONNREFUSED}), true},
+		{"connection reset", nil, wrap(&os.SyscallError{Syscall: "read", Err: syscall.ECONNRESET}), true},
+		{"dial timeout", nil, wrap(&os.SyscallError{Syscall: "connect", Err: syscall.ETIMEDOUT}), true},
+		{"DNS timeout", nil, wrap(&net.DNSError{Err: "timeout", Name: "example.com", IsTimeout: true}), true},
+		{"attempt deadline", nil, &url.Error{Op: "Get", URL: "https://example.com/", Err: context.DeadlineExceeded}, true},
+		{"unknown host", nil, wrap(&net.DNSError{Err: "no such host", Name: "example.invalid", IsNotFound: true}), false},
+		{"blocked address", nil, wrap(errors.New("address 127.0.0.1 is in a blocked network")), false},
+		{"invalid certificate", nil, &url.Error{Op: "Get", URL: "https://example.com/", Err: x509.UnknownAuthorityError{}}, false},
+		{"cancelled", nil, &url.Error{Op: "Get", URL: "https://example.com/", Err: context.Canceled}, false},
+		{"retry status", status(http.StatusServiceUnavailable), nil, true},
+		{"other status", status(http.StatusNotFound), nil, false},
+		{"ok", status(http.StatusOK), nil, false},
+	}
+	config, _ := model.MakeConfiguration()
+	transport := &RetryTransport{Settings: &config.LinkLifecyleSettings(model.SettingsPath(model.DefaultSettingsStoreName)).Retry}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, got := transport.retryable(test.resp, test.err); got != test.want {
+				t.Errorf("retryable(%v, %v) = %v, want %v", test.resp, test.err, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name         string
+		failures     int
+		maxAttempts  int
+		wantAttempts int32
+		wantStatus   int
+	}{
+		{"succeeds first time", 0, 3, 1, http.StatusOK},
+		{"succeeds after retries", 2, 3, 3, http.StatusOK},
+		{"gives up", 5, 3, 3, http.StatusServiceUnavailable},
+		{"single attempt", 5, 1, 1, http.StatusServiceUnavailable},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) <= int32(test.failures) {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			settings := &model.LinkRetrySettings{MaxAttempts: test.maxAttempts, InitialBackoff: model.TimeoutDuration(time.Millisecond),
+				RetryStatusCodes: []int{http.StatusServiceUnavailable}}
+			client := &http.Client{Transport: &RetryTransport{Settings: settings, Breakers: NewCircuitBreakers(0, 0)}}
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Fatalf("Get returned %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != test.wantStatus || attempts != test.wantAttempts {
+				t.Errorf("status %d after %d attempts, want %d after %d", resp.StatusCode, attempts, test.wantStatus, test.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestCircuitBreakers(t *testing.T) {
+	const host = "example.com"
+	cooldown := 50 * time.Millisecond
+	breakers := NewCircuitBreakers(2, cooldown)
+
+	steps := []struct {
+		name    string
+		do      func() bool
+		want    bool
+		advance time.Duration
+	}{
+		{"first failure doesn't trip", func() bool { return breakers.Failure(host) }, false, 0},
+		{"closed breaker allows", func() bool { return breakers.Allow(host) }, true, 0},
+		{"second failure trips", func() bool { return breakers.Failure(host) }, true, 0},
+		{"open breaker refuses", func() bool { return breakers.Allow(host) }, false, cooldown},
+		{"half-open breaker allows a trial", func() bool { return breakers.Allow(host) }, true, 0},
+		{"half-open breaker refuses while the trial runs", func() bool { return breakers.Allow(host) }, false, 0},
+		{"failed trial trips again", func() bool { return breakers.Failure(host) }, true, 0},
+		{"reopened breaker refuses", func() bool { return breakers.Allow(host) }, false, cooldown},
+		{"second trial is allowed", func() bool { return breakers.Allow(host) }, true, 0},
+		{"successful trial closes", func() bool { breakers.Success(host); return breakers.Allow(host) }, true, 0},
+		{"closed breaker allows again", func() bool { return breakers.Allow(host) }, true, 0},
+	}
+	for _, step := range steps {
+		if got := step.do(); got != step.want {
+			t.Fatalf("%s: got %v, want %v", step.name, got, step.want)
+		}
+		time.Sleep(step.advance)
+	}
+}
+
+func TestCircuitBreakersHalfOpenAdmitsOneTrial(t *testing.T) {
+	const host = "example.com"
+	breakers := NewCircuitBreakers(1, 10*time.Millisecond)
+	breakers.Failure(host)
+	time.Sleep(20 * time.Millisecond)
+
+	var allowed int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if breakers.Allow(host) {
+				atomic.AddInt32(&allowed, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	if allowed != 1 {
+		t.Errorf("half-open breaker allowed %d concurrent requests, want 1", allowed)
+	}
+}
@@ -0,0 +1,155 @@
+package source
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/lectio/graph/model"
+)
+
+// ampQueryParams are added to URLs by publishers to request the AMP version of a page
+var ampQueryParams = map[string]string{"amp": "", "outputType": "amp"}
+
+// RewriteURL applies the LinkLifecyleSettings rewrite rules, in order, to u and returns the rewritten URL along
+// with the rules that changed it; rules which produce an invalid URL are skipped with a warning
+func (lm LinksManager) RewriteURL(u *url.URL, warnFn func(code, message string)) (*url.URL, []model.URLRewrite) {
+	var rewrites []model.URLRewrite
+	for _, rule := range lm.LinkSettings.RewriteURLRules {
+		if rule.Match != nil && rule.Type != model.URLRewriteRuleTypeRegExReplace && rule.Type != model.URLRewriteRuleTypeReplaceHost {
+			// for the other rule types match only restricts which URLs the rule applies to
+			if !rule.Match.MatchString(u.String()) {
+				continue
+			}
+		}
+		rewritten, err := rewriteURL(rule, u)
+		if err != nil {
+			warnFn("DLWARN-0104-REWRITE", fmt.Sprintf("Unable to apply rewrite rule %q to %q: %v", rule.Name, u.String(), err))
+			continue
+		}
+		if rewritten.String() != u.String() {
+			rewrites = append(rewrites, model.URLRewrite{Rule: rule.Name, From: model.URLText(u.String()), To: model.URLText(rewritten.String())})
+			u = rewritten
+		}
+	}
+	return u, rewrites
+}
+
+// rewriteURL returns a copy of u changed by a single rule
+func rewriteURL(rule model.URLRewriteRule, u *url.URL) (*url.URL, error) {
+	replacement := ""
+	if rule.Replacement != nil {
+		replacement = *rule.Replacement
+	}
+
+	result := *u
+	switch rule.Type {
+	case model.URLRewriteRuleTypeRegExReplace:
+		if rule.Match == nil {
+			return nil, fmt.Errorf("%s rule requires a match expression", rule.Type)
+		}
+		rewritten, err := url.Parse(rule.Match.ReplaceAllString(u.String(), replacement))
+		if err != nil {
+			return nil, err
+		}
+		if !rewritten.IsAbs() {
+			return nil, fmt.Errorf("%q is not an absolute URL", rewritten.String())
+		}
+		return rewritten, nil
+	case model.URLRewriteRuleTypeReplaceHost:
+		if rule.Match == nil {
+			return nil, fmt.Errorf("%s rule requires a match expression", rule.Type)
+		}
+		host := rule.Match.ReplaceAllString(u.Host, replacement)
+		if len(host) == 0 {
+			return nil, fmt.Errorf("host %q was replaced with an empty host", u.Host)
+		}
+		result.Host = host
+	case model.URLRewriteRuleTypeAMPToCanonical:
+		return ampToCanonical(u)
+	case model.URLRewriteRuleTypeForceHTTPS:
+		if result.Scheme == "http" {
+			result.Scheme = "https"
+			result.Host = strings.TrimSuffix(result.Host, ":80")
+		}
+	case model.URLRewriteRuleTypeStripFragment:
+		result.Fragment = ""
+	case model.URLRewriteRuleTypeSortQueryParams:
+		// url.Values.Encode sorts by key and keeps the order of repeated values
+		if len(result.RawQuery) > 0 {
+			result.RawQuery = result.Query().Encode()
+		}
+	default:
+		return nil, fmt.Errorf("unknown rule type %q", rule.Type)
+	}
+	return &result, nil
+}
+
+// ampToCanonical converts links to AMP caches and AMP versions of pages into the (probable) canonical URL
+func ampToCanonical(u *url.URL) (*url.URL, error) {
+	host := strings.ToLower(u.Hostname())
+	path := u.Path
+
+	// Google AMP viewer and AMP cache links embed the original host and path: /amp/s/example.com/path or
+	// /c/s/example.com/path where the "s" means the original was served over https
+	var embedded string
+	switch {
+	case host == "www.google.com" || host == "google.com":
+		if strings.HasPrefix(path, "/amp/") {
+			embedded = strings.TrimPrefix(path, "/amp/")
+		}
+	case strings.HasSuffix(host, ".cdn.ampproject.org"):
+		for _, prefix := range []string{"/c/", "/v/", "/i/"} {
+			if strings.HasPrefix(path, prefix) {
+				embedded = strings.TrimPrefix(path, prefix)
+				break
+			}
+		}
+	}
+
+	result := *u
+	if len(embedded) > 0 {
+		scheme := "http"
+		if strings.HasPrefix(embedded, "s/") {
+			scheme = "https"
+			embedded = strings.TrimPrefix(embedded, "s/")
+		}
+		original, err := url.Parse(scheme + "://" + embedded)
+		if err != nil {
+			return nil, err
+		}
+		original.RawQuery = u.RawQuery
+		original.Fragment = u.Fragment
+		result = *original
+	}
+
+	if strings.HasPrefix(strings.ToLower(result.Host), "amp.") {
+		result.Host = result.Host[len("amp."):]
+	}
+
+	switch {
+	case strings.HasSuffix(result.Path, "/amp"), strings.HasSuffix(result.Path, "/amp/"):
+		result.Path = strings.TrimSuffix(strings.TrimSuffix(result.Path, "/"), "/amp")
+		if len(result.Path) == 0 {
+			result.Path = "/"
+		}
+	case strings.HasPrefix(result.Path, "/amp/"):
+		result.Path = strings.TrimPrefix(result.Path, "/amp")
+	}
+	result.RawPath = ""
+
+	if len(result.RawQuery) > 0 {
+		query := result.Query()
+		changed := false
+		for param, value := range ampQueryParams {
+			if _, ok := query[param]; ok && (len(value) == 0 || query.Get(param) == value) {
+				query.Del(param)
+				changed = true
+			}
+		}
+		if changed {
+			result.RawQuery = query.Encode()
+		}
+	}
+	return &result, nil
+}
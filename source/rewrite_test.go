@@ -0,0 +1,149 @@
+package source
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/lectio/graph/model"
+)
+
+// rewriteRule creates a rule for the tests, match and replacement are optional
+func rewriteRule(t *testing.T, ruleType model.URLRewriteRuleType, match, replacement string) model.URLRewriteRule {
+	t.Helper()
+	rule := model.URLRewriteRule{Name: model.NameText(ruleType), Type: ruleType}
+	if len(match) > 0 {
+		re, reErr := model.MakeRegularExpression(match)
+		if reErr != nil {
+			t.Fatalf("invalid match %q: %v", match, reErr)
+		}
+		rule.Match = re
+	}
+	if len(replacement) > 0 {
+		rule.Replacement = &replacement
+	}
+	return rule
+}
+
+func TestRewriteURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		ruleType  model.URLRewriteRuleType
+		match     string
+		replace   string
+		urlText   string
+		want      string
+		rewritten bool
+		warned    bool
+	}{
+		{"regex replace", model.URLRewriteRuleTypeRegExReplace, `/print/`, "/", "https://example.com/print/story", "https://example.com/story", true, false},
+		{"regex replace without match", model.URLRewriteRuleTypeRegExReplace, "", "/", "https://example.com/story", "https://example.com/story", false, true},
+		{"regex replace to relative URL", model.URLRewriteRuleTypeRegExReplace, `^https://example.com`, "", "https://example.com/story", "https://example.com/story", false, true},
+		{"replace host", model.URLRewriteRuleTypeReplaceHost, `^mobile\.`, "www.", "https://mobile.example.com/story", "https://www.example.com/story", true, false},
+		{"replace host with empty host", model.URLRewriteRuleTypeReplaceHost, `.*`, "", "https://example.com/story", "https://example.com/story", false, true},
+		{"AMP path suffix", model.URLRewriteRuleTypeAMPToCanonical, "", "", "https://example.com/story/amp/", "https://example.com/story", true, false},
+		{"AMP host", model.URLRewriteRuleTypeAMPToCanonical, "", "", "https://amp.example.com/story", "https://example.com/story", true, false},
+		{"AMP cache", model.URLRewriteRuleTypeAMPToCanonical, "", "", "https://example-com.cdn.ampproject.org/c/s/example.com/story?amp", "https://example.com/story", true, false},
+		{"Google AMP viewer", model.URLRewriteRuleTypeAMPToCanonical, "", "", "https://www.google.com/amp/example.com/amp/story", "http://example.com/story", true, false},
+		{"AMP query param", model.URLRewriteRuleTypeAMPToCanonical, "", "", "https://example.com/story?outputType=amp&id=1", "https://example.com/story?id=1", true, false},
+		{"force HTTPS", model.URLRewriteRuleTypeForceHTTPS, "", "", "http://example.com:80/story", "https://example.com/story", true, false},
+		{"force HTTPS restricted by match", model.URLRewriteRuleTypeForceHTTPS, `other\.com`, "", "http://example.com/story", "http://example.com/story", false, false},
+		{"already HTTPS", model.URLRewriteRuleTypeForceHTTPS, "", "", "https://example.com/story", "https://example.com/story", false, false},
+		{"strip fragment", model.URLRewriteRuleTypeStripFragment, "", "", "https://example.com/story#comments", "https://example.com/story", true, false},
+		{"sort query params", model.URLRewriteRuleTypeSortQueryParams, "", "", "https://example.com/story?b=2&a=1&b=1", "https://example.com/story?a=1&b=2&b=1", true, false},
+		{"unknown rule type", model.URLRewriteRuleType("Unknown"), "", "", "https://example.com/story", "https://example.com/story", false, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lm := LinksManager{LinkSettings: &model.LinkLifecyleSettings{
+				RewriteURLRules: []model.URLRewriteRule{rewriteRule(t, test.ruleType, test.match, test.replace)}}}
+			u, parseErr := url.Parse(test.urlText)
+			if parseErr != nil {
+				t.Fatalf("invalid URL %q: %v", test.urlText, parseErr)
+			}
+			warned := false
+			result, rewrites := lm.RewriteURL(u, func(code, message string) { warned = true })
+			if result.String() != test.want {
+				t.Errorf("rewrote %q to %q, want %q", test.urlText, result.String(), test.want)
+			}
+			if (len(rewrites) > 0) != test.rewritten {
+				t.Errorf("got rewrites %+v, want rewritten %v", rewrites, test.rewritten)
+			}
+			if warned != test.warned {
+				t.Errorf("warned %v, want %v", warned, test.warned)
+			}
+		})
+	}
+}
+
+func TestRewriteURLAppliesRulesInOrder(t *testing.T) {
+	lm := LinksManager{LinkSettings: &model.LinkLifecyleSettings{RewriteURLRules: []model.URLRewriteRule{
+		rewriteRule(t, model.URLRewriteRuleTypeForceHTTPS, "", ""),
+		rewriteRule(t, model.URLRewriteRuleTypeReplaceHost, `^m\.`, "www."),
+		rewriteRule(t, model.URLRewriteRuleTypeStripFragment, "", ""),
+	}}}
+	u, _ := url.Parse("http://m.example.com/story")
+	result, rewrites := lm.RewriteURL(u, func(code, message string) { t.Errorf("unexpected warning %s: %s", code, message) })
+	if result.String() != "https://www.example.com/story" {
+		t.Errorf("got %q", result.String())
+	}
+	want := []model.URLRewrite{
+		{Rule: "ForceHTTPS", From: "http://m.example.com/story", To: "https://m.example.com/story"},
+		{Rule: "ReplaceHost", From: "https://m.example.com/story", To: "https://www.example.com/story"},
+	}
+	if !reflect.DeepEqual(rewrites, want) {
+		t.Errorf("got rewrites %+v, want %+v", rewrites, want)
+	}
+}
+
+func TestFinalizeBookmarkRewritesBeforeTraversal(t *testing.T) {
+	tests := []struct {
+		name     string
+		urlText  string
+		ignore   string
+		want     string
+		rewrites int
+		skipped  bool
+	}{
+		{"rewritten", "http://example.com/story#top", "", "https://example.com/story", 2, false},
+		{"not rewritten", "https://example.com/story", "", "https://example.com/story", 0, false},
+		{"rewritten URL ignored", "http://example.com/story", `^https://`, "", 0, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := newTestParams(t, "https://example.com/links.txt", func(config *model.Configuration, path model.SettingsPath) {
+				settings := config.LinkLifecyleSettings(path)
+				settings.RewriteURLRules = []model.URLRewriteRule{
+					rewriteRule(t, model.URLRewriteRuleTypeForceHTTPS, "", ""),
+					rewriteRule(t, model.URLRewriteRuleTypeStripFragment, "", ""),
+				}
+				settings.IgnoreURLsRegExprs = nil
+				if len(test.ignore) > 0 {
+					re, _ := model.MakeRegularExpression(test.ignore)
+					settings.IgnoreURLsRegExprs = []*model.RegularExpression{re}
+				}
+			})
+			lm := params.LinksManager()
+			bookmark := model.Bookmark{Link: model.BookmarkLink{OriginalURLText: model.URLText(test.urlText)}}
+			finalized := FinalizeBookmark(&bookmark, lm, params.ContentSettings(), func(code, message string) {
+				t.Errorf("unexpected error %s: %s", code, message)
+			}, func(code, message string) {})
+
+			if finalized == test.skipped {
+				t.Fatalf("finalized %v, want %v", finalized, !test.skipped)
+			}
+			if test.skipped {
+				return
+			}
+			if bookmark.Link.FinalURL.Text() != test.want {
+				t.Errorf("final URL %q, want %q", bookmark.Link.FinalURL.Text(), test.want)
+			}
+			if len(bookmark.Link.Rewrites) != test.rewrites {
+				t.Errorf("got rewrites %+v, want %d", bookmark.Link.Rewrites, test.rewrites)
+			}
+			if bookmark.Link.OriginalURLText != model.URLText(test.urlText) {
+				t.Errorf("original URL changed to %q", bookmark.Link.OriginalURLText)
+			}
+		})
+	}
+}
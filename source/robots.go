@@ -0,0 +1,312 @@
+package source
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lectio/graph/model"
+)
+
+// maxRobotsTxtSize limits how much of a robots.txt file is read, larger files are truncated
+const maxRobotsTxtSize = 500 * 1024
+
+// RobotsCache fetches, caches and applies the robots.txt rules of each host, including its crawl-delay
+type RobotsCache struct {
+	settings *model.LinkRobotsSettings
+	mutex    sync.Mutex
+	hosts    map[string]*robotsHost
+}
+
+// robotsHost holds the robots.txt groups of a single host; its mutex ensures robots.txt is only fetched once at a
+// time
+type robotsHost struct {
+	mutex   sync.Mutex
+	groups  []*robotsGroup
+	fetched time.Time
+	next    time.Time
+}
+
+// robotsRules are the rules of the robots.txt groups which apply to a user agent, nil rules allow everything
+type robotsRules struct {
+	rules      []robotsRule
+	crawlDelay time.Duration
+}
+
+type robotsRule struct {
+	allow   bool
+	length  int
+	pattern *regexp.Regexp
+}
+
+type robotsGroup struct {
+	agents     []string
+	rules      []robotsRule
+	crawlDelay time.Duration
+}
+
+var sharedRobotsCaches = struct {
+	sync.Mutex
+	caches map[*model.LinkLifecyleSettings]*RobotsCache
+}{caches: make(map[*model.LinkLifecyleSettings]*RobotsCache)}
+
+// NewRobotsCache creates an empty cache for the given settings
+func NewRobotsCache(settings *model.LinkRobotsSettings) *RobotsCache {
+	result := new(RobotsCache)
+	result.settings = settings
+	result.hosts = make(map[string]*robotsHost)
+	return result
+}
+
+// SharedRobotsCache returns the cache shared by all sources using the same LinkLifecyleSettings
+func SharedRobotsCache(lls *model.LinkLifecyleSettings) *RobotsCache {
+	sharedRobotsCaches.Lock()
+	defer sharedRobotsCaches.Unlock()
+	cache, ok := sharedRobotsCaches.caches[lls]
+	if !ok {
+		cache = NewRobotsCache(&lls.Robots)
+		sharedRobotsCaches.caches[lls] = cache
+	}
+	return cache
+}
+
+// Allowed returns true if robots.txt allows the user agent lm sends to fetch u, otherwise false and a reason
+func (rc *RobotsCache) Allowed(ctx context.Context, lm LinksManager, u *url.URL) (bool, string) {
+	host, key := rc.host(ctx, lm, u)
+	if host == nil {
+		return true, ""
+	}
+	userAgent := lm.UserAgent(u)
+	host.mutex.Lock()
+	rules := matchRobotsGroups(host.groups, userAgent)
+	host.mutex.Unlock()
+	if !rules.allowed(u) {
+		return false, fmt.Sprintf("robots.txt of %q disallows %q for user agent %q", key, u.String(), userAgent)
+	}
+	return true, ""
+}
+
+// Wait blocks until the crawl-delay of u's host since the previous request to it has passed, or ctx is done; it
+// reserves the next slot for the host so that concurrent harvests are spaced by the crawl-delay. URLs robots.txt
+// disallows aren't retrieved so they don't wait. Wait should be called before taking HarvestPool slots so that the
+// slots aren't held while waiting.
+func (rc *RobotsCache) Wait(ctx context.Context, lm LinksManager, u *url.URL) {
+	host, _ := rc.host(ctx, lm, u)
+	if host == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	host.mutex.Lock()
+	rules := matchRobotsGroups(host.groups, lm.UserAgent(u))
+	if !rules.allowed(u) {
+		host.mutex.Unlock()
+		return
+	}
+	delay := rules.delay()
+	if maxDelay := time.Duration(rc.settings.MaxCrawlDelay); maxDelay > 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+	now := time.Now()
+	wait := host.next.Sub(now)
+	if host.next.Before(now) {
+		host.next = now
+	}
+	host.next = host.next.Add(delay)
+	host.mutex.Unlock()
+
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+		}
+	}
+}
+
+// host returns u's host, with its robots.txt fetched when it hasn't been or the cached copy expired, and its
+// scheme and host key; it returns nil when robots.txt isn't honored or doesn't apply to u
+func (rc *RobotsCache) host(ctx context.Context, lm LinksManager, u *url.URL) (*robotsHost, string) {
+	if rc == nil || !rc.settings.HonorRobotsTxt || u == nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, ""
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	rc.mutex.Lock()
+	key := strings.ToLower(u.Scheme + "://" + u.Host)
+	host, ok := rc.hosts[key]
+	if !ok {
+		host = new(robotsHost)
+		rc.hosts[key] = host
+	}
+	rc.mutex.Unlock()
+
+	host.mutex.Lock()
+	if host.fetched.IsZero() || time.Since(host.fetched) > time.Duration(rc.settings.CacheDuration) {
+		host.groups = rc.fetch(ctx, lm, key)
+		host.fetched = time.Now()
+	}
+	host.mutex.Unlock()
+	return host, key
+}
+
+// fetch retrieves and parses robots.txt for the scheme and host in hostURL; a missing or unreachable robots.txt
+// has no groups so it allows everything
+func (rc *RobotsCache) fetch(ctx context.Context, lm LinksManager, hostURL string) []*robotsGroup {
+	req, err := http.NewRequest(http.MethodGet, hostURL+"/robots.txt", nil)
+	if err != nil {
+		return nil
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("User-Agent", lm.UserAgent(req.URL))
+	resp, err := lm.HTTPClient().Do(req)
+	if err != nil {
+		return nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil
+	}
+	return parseRobotsTxt(io.LimitReader(resp.Body, maxRobotsTxtSize))
+}
+
+// parseRobotsTxt returns the groups of user agents and the rules which apply to them
+func parseRobotsTxt(r io.Reader) []*robotsGroup {
+	var groups []*robotsGroup
+	var group *robotsGroup
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if comment := strings.Index(line, "#"); comment >= 0 {
+			line = line[:comment]
+		}
+		separator := strings.Index(line, ":")
+		if separator < 0 {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(line[:separator]))
+		value := strings.TrimSpace(line[separator+1:])
+
+		switch key {
+		case "user-agent":
+			// consecutive user-agent lines share a group, one after any rule starts a new group
+			if group == nil || len(group.rules) > 0 || group.crawlDelay > 0 {
+				group = new(robotsGroup)
+				groups = append(groups, group)
+			}
+			group.agents = append(group.agents, strings.ToLower(value))
+		case "allow", "disallow":
+			if group == nil || len(value) == 0 {
+				continue
+			}
+			if pattern, err := robotsPattern(value); err == nil {
+				group.rules = append(group.rules, robotsRule{allow: key == "allow", length: len(value), pattern: pattern})
+			}
+		case "crawl-delay":
+			if group == nil {
+				continue
+			}
+			if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+				group.crawlDelay = time.Duration(seconds * float64(time.Second))
+			}
+		}
+	}
+	return groups
+}
+
+// matchRobotsGroups returns the rules of the groups which best match userAgent, or those for * if none do
+func matchRobotsGroups(groups []*robotsGroup, userAgent string) *robotsRules {
+	userAgent = strings.ToLower(userAgent)
+	var matched, wildcard []*robotsGroup
+	best := 0
+	for _, g := range groups {
+		for _, agent := range g.agents {
+			switch {
+			case agent == "*":
+				wildcard = append(wildcard, g)
+			case len(agent) > 0 && strings.Contains(userAgent, agent):
+				if len(agent) > best {
+					matched = nil
+					best = len(agent)
+				}
+				if len(agent) == best {
+					matched = append(matched, g)
+				}
+			}
+		}
+	}
+	if len(matched) == 0 {
+		matched = wildcard
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+
+	result := new(robotsRules)
+	for _, g := range matched {
+		result.rules = append(result.rules, g.rules...)
+		if g.crawlDelay > result.crawlDelay {
+			result.crawlDelay = g.crawlDelay
+		}
+	}
+	return result
+}
+
+// robotsPattern converts a robots.txt path pattern, where * matches anything and a trailing $ anchors the end,
+// to a regular expression
+func robotsPattern(value string) (*regexp.Regexp, error) {
+	anchored := strings.HasSuffix(value, "$")
+	value = strings.TrimSuffix(value, "$")
+	expression := "^" + strings.Replace(regexp.QuoteMeta(value), `\*`, ".*", -1)
+	if anchored {
+		expression += "$"
+	}
+	return regexp.Compile(expression)
+}
+
+// allowed applies the longest matching rule to u's path and query, allow wins when rules are equally long
+func (r *robotsRules) allowed(u *url.URL) bool {
+	if r == nil {
+		return true
+	}
+	path := u.EscapedPath()
+	if len(path) == 0 {
+		path = "/"
+	}
+	if len(u.RawQuery) > 0 {
+		path += "?" + u.RawQuery
+	}
+
+	result := true
+	longest := -1
+	for _, rule := range r.rules {
+		if !rule.pattern.MatchString(path) {
+			continue
+		}
+		if rule.length > longest || (rule.length == longest && rule.allow) {
+			result = rule.allow
+			longest = rule.length
+		}
+	}
+	return result
+}
+
+func (r *robotsRules) delay() time.Duration {
+	if r == nil {
+		return 0
+	}
+	return r.crawlDelay
+}
@@ -0,0 +1,257 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lectio/graph/model"
+)
+
+const testRobotsTxt = `
+# comments and blank lines are ignored
+User-agent: *
+Disallow: /private/
+Allow: /private/public$
+Crawl-delay: 0.1
+
+User-agent: lectio
+User-agent: other
+Disallow: /lectio-only
+Allow: /private/
+
+User-agent: strict-bot
+Disallow: /
+`
+
+// newRobotsTestServer serves robots.txt and records the User-Agent each robots.txt request was sent with
+func newRobotsTestServer(robotsTxt string) (*httptest.Server, func() []string) {
+	var mutex sync.Mutex
+	var agents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		mutex.Lock()
+		agents = append(agents, r.Header.Get("User-Agent"))
+		mutex.Unlock()
+		fmt.Fprint(w, robotsTxt)
+	}))
+	return server, func() []string {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]string(nil), agents...)
+	}
+}
+
+// newRobotsTestLinksManager returns a LinksManager which sends userAgent, or policyAgent to the test server's
+// host when it's not empty
+func newRobotsTestLinksManager(server *httptest.Server, userAgent, policyAgent string) LinksManager {
+	lls := &model.LinkLifecyleSettings{Robots: model.LinkRobotsSettings{HonorRobotsTxt: true}}
+	lls.Robots.CacheDuration.UnmarshalGQL("1h")
+	lls.Robots.MaxCrawlDelay.UnmarshalGQL("1s")
+	if len(policyAgent) > 0 {
+		serverURL, _ := url.Parse(server.URL)
+		lls.DomainPolicies = []model.LinkDomainPolicy{{Domains: []string{serverURL.Hostname()}, UserAgent: &policyAgent}}
+	}
+	return LinksManager{
+		LinkSettings:   lls,
+		ClientSettings: &model.HTTPClientSettings{UserAgent: userAgent},
+		Client:         server.Client(),
+		Robots:         NewRobotsCache(&lls.Robots)}
+}
+
+func TestRobotsAllowed(t *testing.T) {
+	tests := []struct {
+		name        string
+		userAgent   string
+		policyAgent string
+		path        string
+		want        bool
+	}{
+		{"wildcard allows", "github.com/lectio/graph", "", "/story", true},
+		{"wildcard disallows", "Mozilla/5.0", "", "/private/story", false},
+		{"wildcard longer allow wins", "Mozilla/5.0", "", "/private/public", true},
+		{"wildcard anchored allow", "Mozilla/5.0", "", "/private/public/more", false},
+		{"sent user agent matches group", "github.com/lectio/graph", "", "/private/story", true},
+		{"sent user agent disallowed", "github.com/lectio/graph", "", "/lectio-only", false},
+		{"user agent match ignores case", "Lectio/1.0", "", "/lectio-only", false},
+		{"domain policy user agent", "github.com/lectio/graph", "Strict-Bot/2.0", "/story", false},
+		{"domain policy user agent falls back to wildcard", "github.com/lectio/graph", "Mozilla/5.0", "/lectio-only", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, agents := newRobotsTestServer(testRobotsTxt)
+			defer server.Close()
+			lm := newRobotsTestLinksManager(server, test.userAgent, test.policyAgent)
+			u, _ := url.Parse(server.URL + test.path)
+
+			allowed, reason := lm.Robots.Allowed(context.Background(), lm, u)
+			if allowed != test.want {
+				t.Errorf("allowed %v (%s), want %v", allowed, reason, test.want)
+			}
+			if !allowed && !strings.Contains(reason, lm.UserAgent(u)) {
+				t.Errorf("reason %q doesn't name the user agent %q", reason, lm.UserAgent(u))
+			}
+
+			// robots.txt is requested once, with the user agent that the rules are evaluated for
+			lm.Robots.Allowed(context.Background(), lm, u)
+			if got := agents(); len(got) != 1 || got[0] != lm.UserAgent(u) {
+				t.Errorf("robots.txt requested with %q, want once with %q", got, lm.UserAgent(u))
+			}
+		})
+	}
+}
+
+func TestRobotsNotHonored(t *testing.T) {
+	tests := []struct {
+		name    string
+		honor   bool
+		nilSelf bool
+		urlText string
+	}{
+		{"not honored", false, false, "/private/story"},
+		{"no cache", true, true, "/private/story"},
+		{"not HTTP", true, false, "ftp://example.com/private/story"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, agents := newRobotsTestServer(testRobotsTxt)
+			defer server.Close()
+			lm := newRobotsTestLinksManager(server, "Mozilla/5.0", "")
+			lm.LinkSettings.Robots.HonorRobotsTxt = test.honor
+			if test.nilSelf {
+				lm.Robots = nil
+			}
+			urlText := test.urlText
+			if strings.HasPrefix(urlText, "/") {
+				urlText = server.URL + urlText
+			}
+			u, _ := url.Parse(urlText)
+			if allowed, reason := lm.Robots.Allowed(context.Background(), lm, u); !allowed {
+				t.Errorf("disallowed: %s", reason)
+			}
+			lm.Robots.Wait(context.Background(), lm, u)
+			if got := agents(); len(got) > 0 {
+				t.Errorf("robots.txt was requested %d times", len(got))
+			}
+		})
+	}
+}
+
+func TestRobotsMissing(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	lm := newRobotsTestLinksManager(server, "Mozilla/5.0", "")
+	u, _ := url.Parse(server.URL + "/private/story")
+	if allowed, reason := lm.Robots.Allowed(context.Background(), lm, u); !allowed {
+		t.Errorf("disallowed without robots.txt: %s", reason)
+	}
+}
+
+func TestRobotsWait(t *testing.T) {
+	tests := []struct {
+		name      string
+		robotsTxt string
+		maxDelay  string
+		path      string
+		cancel    bool
+		wantWait  bool
+	}{
+		{"crawl-delay spaces requests", testRobotsTxt, "1s", "/story", false, true},
+		{"crawl-delay limited by max", "User-agent: *\nCrawl-delay: 60\n", "100ms", "/story", false, true},
+		{"disallowed doesn't wait", testRobotsTxt, "1s", "/private/story", false, false},
+		{"no crawl-delay", "User-agent: *\nDisallow: /private/\n", "1s", "/story", false, false},
+		{"cancelled", "User-agent: *\nCrawl-delay: 60\n", "1m", "/story", true, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, _ := newRobotsTestServer(test.robotsTxt)
+			defer server.Close()
+			lm := newRobotsTestLinksManager(server, "Mozilla/5.0", "")
+			lm.LinkSettings.Robots.MaxCrawlDelay.UnmarshalGQL(test.maxDelay)
+			u, _ := url.Parse(server.URL + test.path)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			lm.Robots.Wait(ctx, lm, u)
+			if test.cancel {
+				cancel()
+			}
+			started := time.Now()
+			lm.Robots.Wait(ctx, lm, u)
+			waited := time.Since(started)
+			if test.wantWait && waited < 80*time.Millisecond {
+				t.Errorf("second request waited %v, want the crawl-delay", waited)
+			}
+			if !test.wantWait && waited > 50*time.Millisecond {
+				t.Errorf("second request waited %v, want no wait", waited)
+			}
+		})
+	}
+}
+
+func TestRobotsWaitDoesNotHoldPoolSlots(t *testing.T) {
+	server, _ := newRobotsTestServer("User-agent: *\nCrawl-delay: 0.3\n")
+	defer server.Close()
+	lm := newRobotsTestLinksManager(server, "Mozilla/5.0", "")
+	lm.Pool = NewHarvestPool(1, 0)
+	slow, _ := url.Parse(server.URL + "/story")
+
+	// the first harvest of the slow host reserves its crawl-delay, the second waits for it the way
+	// FinalizeBookmark does, before it takes a pool slot
+	lm.Robots.Wait(context.Background(), lm, slow)
+	go func() {
+		lm.Robots.Wait(context.Background(), lm, slow)
+		release, _ := lm.Pool.Acquire(context.Background(), slow.String())
+		release()
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	acquired := make(chan struct{})
+	go func() {
+		release, _ := lm.Pool.Acquire(context.Background(), "https://fast.example.com/story")
+		release()
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+	case <-time.After(150 * time.Millisecond):
+		t.Error("another host's harvest waited for the slow host's crawl-delay")
+	}
+}
+
+func TestMatchRobotsGroups(t *testing.T) {
+	groups := parseRobotsTxt(strings.NewReader(testRobotsTxt))
+	if len(groups) != 3 {
+		t.Fatalf("got %d groups, want 3", len(groups))
+	}
+	tests := []struct {
+		userAgent string
+		rules     int
+		delay     time.Duration
+	}{
+		{"Mozilla/5.0", 2, 100 * time.Millisecond},
+		{"github.com/lectio/graph", 2, 0},
+		{"other-agent", 2, 0},
+		{"strict-bot", 1, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.userAgent, func(t *testing.T) {
+			rules := matchRobotsGroups(groups, test.userAgent)
+			if rules == nil || len(rules.rules) != test.rules || rules.delay() != test.delay {
+				t.Errorf("got %+v, want %d rules and delay %v", rules, test.rules, test.delay)
+			}
+		})
+	}
+	if rules := matchRobotsGroups(parseRobotsTxt(strings.NewReader("Disallow: /\n")), "lectio"); rules != nil {
+		t.Errorf("rules outside of a group apply: %+v", rules)
+	}
+}
@@ -0,0 +1,88 @@
+package source
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lectio/graph/model"
+)
+
+// SyncWatermark remembers the newest item update time seen in a previous harvest of a source so that
+// incremental harvests can skip unchanged items
+type SyncWatermark struct {
+	Since       time.Time
+	Newest      time.Time
+	Processed   int
+	Skipped     int
+	Failed      int
+	timestamped bool
+	updatedAt   map[string]time.Time
+	mutex       sync.Mutex
+}
+
+// NewSyncWatermark creates a watermark which treats items updated after since as new or changed
+func NewSyncWatermark(since time.Time) *SyncWatermark {
+	result := new(SyncWatermark)
+	result.Since = since
+	result.Newest = since
+	result.updatedAt = make(map[string]time.Time)
+	return result
+}
+
+// Changed returns true if an item updated at updatedAt is new or changed since the watermark, counting it as
+// processed; unchanged items are counted as skipped
+func (w *SyncWatermark) Changed(updatedAt time.Time) bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.timestamped = true
+	if !updatedAt.IsZero() && !updatedAt.After(w.Since) {
+		w.Skipped++
+		return false
+	}
+
+	w.Processed++
+	return true
+}
+
+// Timestamped returns true if the source told when its items were updated; the watermark of a source which
+// doesn't can't be used to skip anything
+func (w *SyncWatermark) Timestamped() bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.timestamped
+}
+
+// harvested remembers when the item the bookmark identified by id was created from was updated, so that the
+// watermark advances once the bookmark is written
+func (w *SyncWatermark) harvested(id string, updatedAt time.Time) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if updatedAt.After(w.updatedAt[id]) {
+		w.updatedAt[id] = updatedAt
+	}
+}
+
+// failed counts an item whose harvest reported an error, it must be harvested again next time
+func (w *SyncWatermark) failed() {
+	w.mutex.Lock()
+	w.Failed++
+	w.mutex.Unlock()
+}
+
+// Written advances Newest to the update time of the item the bookmark was created from, it should only be called
+// once the bookmark is safely stored
+func (w *SyncWatermark) Written(bookmark *model.Bookmark) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if updatedAt := w.updatedAt[bookmark.ID]; updatedAt.After(w.Newest) {
+		w.Newest = updatedAt
+	}
+}
+
+// Advanced returns true if a newer item was written than the watermark started with
+func (w *SyncWatermark) Advanced() bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.Newest.After(w.Since)
+}
@@ -0,0 +1,101 @@
+package source
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lectio/graph/model"
+)
+
+func TestSyncWatermarkHarvestChanged(t *testing.T) {
+	since := time.Date(2019, 9, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time {
+		return since.AddDate(0, 0, n)
+	}
+
+	type item struct {
+		updatedAt time.Time
+		fails     bool
+		written   bool
+	}
+	tests := []struct {
+		name          string
+		items         []item
+		wantProcessed int
+		wantSkipped   int
+		wantFailed    int
+		wantNewest    time.Time
+	}{
+		{"unchanged items are skipped", []item{{day(-1), false, true}, {since, false, true}}, 0, 2, 0, since},
+		{"newest written item advances", []item{{day(1), false, true}, {day(3), false, true}, {day(-2), false, true}}, 2, 1, 0, day(3)},
+		{"unwritten items don't advance", []item{{day(1), false, true}, {day(3), false, false}}, 2, 0, 0, day(1)},
+		{"failed items are counted", []item{{day(1), false, true}, {day(2), true, true}}, 2, 0, 1, day(1)},
+		{"items without a time are processed", []item{{time.Time{}, false, true}}, 1, 0, 0, since},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			watermark := NewSyncWatermark(since)
+			params := newTestParams(t, "https://example.com/sync.json", nil)
+			params.(*defaultLinksAPIHandlerParams).watermark = watermark
+			source := params.Source().(*model.BookmarksAPISource)
+
+			bookmarks := NewBookmarksHarvester(params, source).HarvestChanged(len(test.items),
+				func(index int) time.Time {
+					return test.items[index].updatedAt
+				},
+				func(index int) string {
+					return fmt.Sprintf("item %d", index)
+				},
+				func(index int, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+					if test.items[index].fails {
+						errorFn("TEST-0001", "item failed")
+						return nil
+					}
+					return &model.Bookmark{ID: fmt.Sprintf("%d", index), Properties: model.MakeProperties()}
+				})
+
+			for _, bookmark := range bookmarks.Content {
+				var index int
+				fmt.Sscan(bookmark.ID, &index)
+				if test.items[index].written {
+					watermark.Written(&bookmark)
+				}
+			}
+			if !watermark.Timestamped() {
+				t.Errorf("Timestamped() = false, want true")
+			}
+			if watermark.Processed != test.wantProcessed || watermark.Skipped != test.wantSkipped || watermark.Failed != test.wantFailed {
+				t.Errorf("processed %d, skipped %d, failed %d; want %d, %d, %d", watermark.Processed, watermark.Skipped, watermark.Failed,
+					test.wantProcessed, test.wantSkipped, test.wantFailed)
+			}
+			if !watermark.Newest.Equal(test.wantNewest) {
+				t.Errorf("Newest = %v, want %v", watermark.Newest, test.wantNewest)
+			}
+			if watermark.Advanced() != test.wantNewest.After(since) {
+				t.Errorf("Advanced() = %v, want %v", watermark.Advanced(), test.wantNewest.After(since))
+			}
+		})
+	}
+}
+
+func TestSyncWatermarkWithoutTimestamps(t *testing.T) {
+	watermark := NewSyncWatermark(time.Time{})
+	params := newTestParams(t, "https://example.com/links.txt", nil)
+	params.(*defaultLinksAPIHandlerParams).watermark = watermark
+	source := params.Source().(*model.BookmarksAPISource)
+
+	bookmarks := NewBookmarksHarvester(params, source).Harvest(2,
+		func(index int) string {
+			return fmt.Sprintf("item %d", index)
+		},
+		func(index int, errorFn func(code, message string), warnFn func(code, message string)) *model.Bookmark {
+			return &model.Bookmark{ID: fmt.Sprintf("%d", index), Properties: model.MakeProperties()}
+		})
+	if len(bookmarks.Content) != 2 {
+		t.Fatalf("Harvest returned %d bookmarks, want 2", len(bookmarks.Content))
+	}
+	if watermark.Timestamped() || watermark.Processed != 0 || watermark.Advanced() {
+		t.Errorf("watermark of a source without timestamps was used: %+v", watermark)
+	}
+}
@@ -0,0 +1,35 @@
+package source
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/lectio/graph/model"
+)
+
+// URLListSourceName is the registered name of the newline-delimited URLs source (files or stdin)
+const URLListSourceName = "URLList"
+
+func init() {
+	pattern, _ := model.MakeRegularExpression(`(?i)^(file\://.+\.(txt|urls|list)|stdin\://.*|-)$`)
+	MustRegister(&Registration{Name: URLListSourceName, URLPattern: pattern, Handler: NewListLinksHandler("URL", ParseURLList)})
+}
+
+// ParseURLList reads one URL per line, skipping blank lines and lines starting with #
+func ParseURLList(content []byte, ss *model.SourceSettings) ([]*ListItem, error) {
+	var result []*ListItem
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		result = append(result, &ListItem{Link: line})
+	}
+	if err := scanner.Err(); err != nil {
+		return result, fmt.Errorf("unable to read URL list: %v", err)
+	}
+	return result, nil
+}